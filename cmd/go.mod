@@ -0,0 +1,24 @@
+module github.com/n4x2/zoo/cmd
+
+go 1.25.0
+
+require (
+	github.com/n4x2/zoo v0.0.0
+	github.com/n4x2/zoo/validator/analyzer v0.0.0
+	golang.org/x/tools v0.44.0
+)
+
+require (
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/net v0.53.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
+	golang.org/x/text v0.36.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// Tools are installed from a checkout of the repository, zoo and the
+// analyzer are replaced with their directories.
+replace (
+	github.com/n4x2/zoo => ../
+	github.com/n4x2/zoo/validator/analyzer => ../validator/analyzer
+)
@@ -0,0 +1,756 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/n4x2/zoo/is"
+	"github.com/n4x2/zoo/to"
+	"github.com/n4x2/zoo/validator"
+)
+
+// Import paths used by generated code.
+const (
+	isPath        = "github.com/n4x2/zoo/is"
+	validatorPath = "github.com/n4x2/zoo/validator"
+)
+
+// generator holds state of code generation of a package.
+type generator struct {
+	buf     bytes.Buffer
+	imports map[string]bool
+	vars    *[]string     // Programs of expressions of the current type.
+	tn      string        // Name of the current type.
+	st      *types.Struct // The current type.
+}
+
+// printf writes formatted code into the generator buffer.
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// generate generates source of Validate methods of struct types 'names'
+// of package 'pkg', or of every struct with validator tags if 'names' is
+// empty. It returns an error if a type is not a struct, a field is
+// unexported or missing validator tag, or a tag cannot be applied to the
+// field type.
+func generate(pkg *types.Package, names []string) ([]byte, error) {
+	var all = len(names) == 0
+	if all {
+		names = pkg.Scope().Names()
+	}
+	sort.Strings(names)
+
+	var g = generator{imports: make(map[string]bool)}
+	for _, n := range names {
+		tn, ok := pkg.Scope().Lookup(n).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			if all {
+				continue
+			}
+			return nil, fmt.Errorf("type %s not found", n)
+		}
+
+		nt, _ := tn.Type().(*types.Named)
+		st, ok := tn.Type().Underlying().(*types.Struct)
+		if !ok || nt == nil || nt.TypeParams().Len() > 0 {
+			if all {
+				continue
+			}
+			return nil, fmt.Errorf("type %s is not a struct", n)
+		}
+
+		if all && !tagged(st) {
+			continue
+		}
+
+		if err := g.method(n, st); err != nil {
+			return nil, err
+		}
+	}
+
+	var paths = make([]string, 0, len(g.imports))
+	for p := range g.imports {
+		paths = append(paths, p)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if std(paths[i]) != std(paths[j]) {
+			return std(paths[i])
+		}
+		return paths[i] < paths[j]
+	})
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by zoo-validate-gen; DO NOT EDIT.\n\npackage %s\n", pkg.Name())
+	if len(paths) > 0 {
+		fmt.Fprintf(&src, "\nimport (\n")
+		for i, p := range paths {
+			// Standard library imports come first, separated from the
+			// others by a blank line.
+			if i > 0 && std(paths[i-1]) != std(p) {
+				fmt.Fprintf(&src, "\n")
+			}
+			fmt.Fprintf(&src, "\t%q\n", p)
+		}
+		fmt.Fprintf(&src, ")\n")
+	}
+	src.Write(g.buf.Bytes())
+
+	b, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w", err)
+	}
+	return b, nil
+}
+
+// std checks if import path 'p' belongs to the standard library.
+func std(p string) bool {
+	n, _, _ := strings.Cut(p, "/")
+	return !strings.Contains(n, ".")
+}
+
+// tagged checks if any field of struct 'st' has validator tag.
+func tagged(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		if _, ok := reflect.StructTag(st.Tag(i)).Lookup(validator.ValidatorTag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// method generates Validate method of struct type 'n'.
+func (g *generator) method(n string, st *types.Struct) error {
+	var body generator
+	body.imports = g.imports
+
+	var vars []string
+
+	for i := 0; i < st.NumFields(); i++ {
+		var f = st.Field(i)
+		if !f.Exported() {
+			return fmt.Errorf("%s.%s: unexported field encountered", n, f.Name())
+		}
+
+		var tag = reflect.StructTag(st.Tag(i))
+		vt, ok := tag.Lookup(validator.ValidatorTag)
+		_, mod := tag.Lookup(validator.ModTag)
+		_, def := tag.Lookup(validator.DefaultTag)
+		if !ok && (mod || def) {
+			// Fields having only modifiers or defaults are not validated,
+			// they are applied by validator.Validator.Modify.
+			continue
+		}
+
+		if !ok || vt == "" {
+			return fmt.Errorf("%s.%s: missing validator tag", n, f.Name())
+		}
+
+		var checks = generator{imports: g.imports, vars: &vars, tn: n, st: st}
+		if err := checks.field("x."+f.Name(), f.Type(), vt); err != nil {
+			return fmt.Errorf("%s.%s: %w", n, f.Name(), err)
+		}
+
+		if checks.buf.Len() == 0 {
+			continue
+		}
+
+		body.printf("\n\tm = nil\n")
+		body.buf.Write(checks.buf.Bytes())
+		body.printf("\tif len(m) > 0 {\n\t\tres = append(res, validator.Result{F: %q, E: m})\n\t}\n", fieldName(f.Name(), tag))
+	}
+
+	if len(vars) > 0 {
+		g.printf("\n// Programs and policies of rules of %s.\nvar (\n", n)
+		for _, v := range vars {
+			g.printf("\t%s\n", v)
+		}
+		g.printf(")\n")
+	}
+
+	g.printf("\n// Validate validates %s against its validator tags, see\n", n)
+	g.printf("// [validator.Validator.ValidateStruct]. It returns\n// [validator.ValidationError] if any validation error encountered.\n")
+	g.printf("func (x %s) Validate() error {\n", n)
+	if body.buf.Len() > 0 {
+		g.imports[validatorPath] = true
+		g.printf("\tvar res []validator.Result\n\tvar m []string\n")
+		g.buf.Write(body.buf.Bytes())
+		g.printf("\n\tif len(res) > 0 {\n\t\treturn &validator.ValidationError{Results: res}\n\t}\n")
+	}
+	g.printf("\treturn nil\n}\n")
+	return nil
+}
+
+// fieldName returns result name of field 'n' with tag 'tag' like
+// [validator.Validator.ValidateStruct].
+func fieldName(n string, tag reflect.StructTag) string {
+	jn, _, _ := strings.Cut(tag.Get(validator.JSONTag), validator.ParamSep)
+	if jn == "" || jn == validator.SkipTag {
+		return n
+	}
+	return jn
+}
+
+// field generates checks of value 'x' of type 't' against validator tag
+// 'tag'. It returns an error if 't' implements driver.Valuer, as values of
+// such types are only known once unwrapped by validator.
+func (g *generator) field(x string, t types.Type, tag string) error {
+	e, err := validator.ParseExprFunc(tag, func(part string) (validator.Tag, error) {
+		var n, _, _ = strings.Cut(part, validator.PairSep)
+		if d, ok := validator.R[n]; n != validator.SkipTag && n != validator.ExprRule && (!ok || isFunc(d.Fn) == "") {
+			return validator.Tag{N: n}, nil
+		}
+
+		pt, err := validator.ParseTag(part)
+		if err != nil {
+			return validator.Tag{}, err
+		}
+		return pt[0], nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var rules bool
+	e.Walk(func(tag validator.Tag) {
+		rules = rules || tag.N != validator.SkipTag
+	})
+
+	if rules && method(t, "Value") {
+		return fmt.Errorf("unsupported type %s: values of driver.Valuer are unwrapped at validation time", t)
+	}
+	return g.expr(x, t, e)
+}
+
+// expr generates checks of value 'x' of type 't' against expression 'e'.
+// Alternatives and negations are checked in closures returning their
+// messages, which are passed to [validator.Or] and [validator.Not].
+func (g *generator) expr(x string, t types.Type, e *validator.Expr) error {
+	switch e.Op {
+	case validator.OpAnd:
+		for _, xe := range e.X {
+			if err := g.expr(x, t, xe); err != nil {
+				return err
+			}
+		}
+		return nil
+	case validator.OpOr:
+		g.printf("\tm = append(m, validator.Or(\n")
+		for _, xe := range e.X {
+			if err := g.closure(x, t, xe); err != nil {
+				return err
+			}
+			g.printf(",\n")
+		}
+		g.printf(")...)\n")
+		return nil
+	case validator.OpNot:
+		g.printf("\tm = append(m, validator.Not(%q, ", e.X[0])
+		if err := g.closure(x, t, e.X[0]); err != nil {
+			return err
+		}
+		g.printf(")...)\n")
+		return nil
+	}
+
+	var n = e.Tag.N
+	if n == validator.SkipTag {
+		return nil
+	}
+
+	if n == validator.ExprRule {
+		if err := g.program(x, e.Tag); err != nil {
+			return fmt.Errorf("tag %s: %w", n, err)
+		}
+		return nil
+	}
+
+	d := validator.R[n]
+	var fn = isFunc(d.Fn)
+	if fn == "" {
+		g.printf("\t_ = %s // Unknown tag %q.\n", unknown(n), n)
+		return nil
+	}
+
+	p, ptr := t.(*types.Pointer)
+	if ptr = ptr && !d.Absent; ptr {
+		// Nil pointers are absent, so they are only validated by rules
+		// such as required. Other values are validated by their element
+		// like validator does, math/big values are taken by pointer.
+		g.printf("\tif %s != nil {\n", x)
+		if _, ok := p.Elem().Underlying().(*types.Basic); ok || isTime(p.Elem()) {
+			x, t = "*"+x, p.Elem()
+		}
+	}
+
+	if err := g.rule(x, t, e.Tag, d.Fn, fn); err != nil {
+		return fmt.Errorf("tag %s: %w", n, err)
+	}
+
+	if ptr {
+		g.printf("\t}\n")
+	}
+	return nil
+}
+
+// program generates check of value 'x' against expression of [ExprRule]
+// tag 'tag', compiled once into package-level variable. Sibling fields
+// are resolved by a closure returning fields of the receiver.
+func (g *generator) program(x string, tag validator.Tag) error {
+	src, _ := tag.P[0].(string)
+	p, err := validator.ParseProgram(src)
+	if err != nil {
+		return err
+	}
+
+	var v = fmt.Sprintf("expr%s%d", g.tn, len(*g.vars))
+	*g.vars = append(*g.vars, fmt.Sprintf("%s = validator.MustParseProgram(%q)", v, src))
+
+	var scope = "nil"
+	if len(p.Fields()) > 0 {
+		var b strings.Builder
+		b.WriteString("func(n string) (any, bool) {\nswitch n {\n")
+		for _, fn := range p.Fields() {
+			f, ok := g.lookup(fn)
+			if !ok {
+				return fmt.Errorf("unknown field .%s", fn)
+			}
+			fmt.Fprintf(&b, "case %q:\nreturn x.%s, true\n", fn, f)
+		}
+		b.WriteString("}\nreturn nil, false\n}")
+		scope = b.String()
+	}
+
+	g.imports["fmt"] = true
+	g.printf("\tif ok, err := %s.Eval(%s, %s); err != nil || !ok {\n", v, x, scope)
+	g.printf("\t\tm = append(m, fmt.Sprintf(validator.E[%q], %s))\n\t}\n", tag.N, v)
+	return nil
+}
+
+// lookup returns name of exported field of the current type named 'n'
+// or by JSON name 'n', like fields referenced by expressions are resolved
+// by validator.
+func (g *generator) lookup(n string) (string, bool) {
+	for i := 0; i < g.st.NumFields(); i++ {
+		if f := g.st.Field(i); f.Exported() && f.Name() == n {
+			return n, true
+		}
+	}
+
+	for i := 0; i < g.st.NumFields(); i++ {
+		var f = g.st.Field(i)
+		if f.Exported() && fieldName(f.Name(), reflect.StructTag(g.st.Tag(i))) == n {
+			return f.Name(), true
+		}
+	}
+	return "", false
+}
+
+// closure generates function literal call returning messages of checks of
+// value 'x' of type 't' against expression 'e'.
+func (g *generator) closure(x string, t types.Type, e *validator.Expr) error {
+	g.printf("func() (m []string) {\n")
+	if err := g.expr(x, t, e); err != nil {
+		return err
+	}
+	g.printf("\treturn m\n}()")
+	return nil
+}
+
+// rule generates check of value 'x' of type 't' against tag 'tag', which
+// calls 'fn' function of [is] package having the same signature as
+// validator function 'v'.
+func (g *generator) rule(x string, t types.Type, tag validator.Tag, v any, fn string) error {
+	var msg = fmt.Sprintf("validator.E[%q]", tag.N)
+
+	switch v.(type) {
+	case func(any) bool:
+		g.imports[isPath] = true
+		g.printf("\tif !%s(%s) {\n\t\tm = append(m, %s)\n\t}\n", fn, x, msg)
+	case func(string) bool:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		g.imports[isPath] = true
+		g.printf("\tif !%s(%s) {\n\t\tm = append(m, %s)\n\t}\n", fn, val, msg)
+	case func(int, int) bool:
+		var cond = make([]string, len(tag.P))
+		var args = make([]string, len(tag.P))
+		for i, tp := range tag.P {
+			ps, _ := tp.(string)
+			if !ordered(t) {
+				return errType(t, "number")
+			}
+
+			if val, p, ok := native(x, t, ps); ok {
+				cond[i] = fmt.Sprintf("!%s(%s, %s)", fn, val, p)
+			} else {
+				cond[i] = fmt.Sprintf("c, err := validator.Compare(%s, %q); err != nil || !%s(0, -c)", x, ps, fn)
+			}
+			args[i] = strconv.Quote(ps)
+		}
+		g.chain(cond, msg, args)
+	case func(int, int, int) bool:
+		if !ordered(t) {
+			return errType(t, "number")
+		}
+
+		if len(tag.P) != 2 {
+			return fmt.Errorf("only accept 2 parameter")
+		}
+
+		var lo, _ = tag.P[0].(string)
+		var hi, _ = tag.P[1].(string)
+		var args = []string{strconv.Quote(lo) + ", " + strconv.Quote(hi)}
+
+		val, plo, ok := native(x, t, lo)
+		_, phi, ok2 := native(x, t, hi)
+		if ok && ok2 {
+			g.chain([]string{fmt.Sprintf("!%s(%s, %s, %s)", fn, plo, phi, val)}, msg, args)
+			break
+		}
+
+		g.chain([]string{fmt.Sprintf("!func() bool {\nlo, err := validator.Compare(%s, %q)\nif err != nil {\nreturn false\n}\nhi, err := validator.Compare(%s, %q)\nreturn err == nil && %s(-lo, -hi, 0)\n}()", x, lo, x, hi, fn)}, msg, args)
+	case func(string, int, int) bool:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		var p [2]int
+		for i := range p {
+			pi, err := to.Int(tag.P[i])
+			if err != nil {
+				return errParam(tag.P[i], "int")
+			}
+			p[i] = pi
+		}
+		g.chain([]string{fmt.Sprintf("!%s(%s, %d, %d)", fn, val, p[0], p[1])}, msg, []string{fmt.Sprintf("%d, %d", p[0], p[1])})
+	case func([]string, string) bool:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		var p = make([]string, len(tag.P))
+		for i, tp := range tag.P {
+			s, ok := tp.(string)
+			if !ok {
+				return errParam(tp, "string")
+			}
+			p[i] = strconv.Quote(s)
+		}
+		g.chain([]string{fmt.Sprintf("!%s([]string{%s}, %s)", fn, strings.Join(p, ", "), val)}, msg, []string{x})
+	case func(string, string) bool:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		var cond = make([]string, len(tag.P))
+		var args = make([]string, len(tag.P))
+		for i, tp := range tag.P {
+			s, err := to.String(tp)
+			if err != nil {
+				return errParam(tp, "string")
+			}
+			cond[i] = fmt.Sprintf("!%s(%s, %s)", fn, val, strconv.Quote(s))
+			args[i] = strconv.Quote(s)
+		}
+		g.chain(cond, msg, args)
+	case func(time.Time, time.Time) bool:
+		var cond = make([]string, len(tag.P))
+		var args = make([]string, len(tag.P))
+		for i, tp := range tag.P {
+			var p = "time.Now()"
+			if tp != validator.NowParam {
+				s, err := to.String(tp)
+				if err != nil {
+					return errParam(tp, "time.Time")
+				}
+
+				pt, ok := validator.ParseTime(s)
+				if !ok {
+					return errParam(tp, "time.Time")
+				}
+				p = fmt.Sprintf("time.Unix(%d, %d)", pt.Unix(), pt.Nanosecond())
+			}
+
+			c, err := g.timeCond(x, t, fn, p)
+			if err != nil {
+				return err
+			}
+			cond[i] = c
+			args[i] = literal(tp)
+		}
+		g.chain(cond, msg, args)
+	case func(time.Time, time.Duration) bool:
+		var cond = make([]string, len(tag.P))
+		var args = make([]string, len(tag.P))
+		for i, tp := range tag.P {
+			s, err := to.String(tp)
+			if err != nil {
+				return errParam(tp, "time.Duration")
+			}
+
+			pd, err := time.ParseDuration(s)
+			if err != nil {
+				return errParam(tp, "time.Duration")
+			}
+
+			c, err := g.timeCond(x, t, fn, fmt.Sprintf("time.Duration(%d)", pd))
+			if err != nil {
+				return err
+			}
+			cond[i] = c
+			args[i] = fmt.Sprintf("time.Duration(%d)", pd)
+		}
+		g.chain(cond, msg, args)
+	case func(string, is.PasswordPolicy) []string:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		policy, err := validator.ParsePasswordPolicy(strs(tag)...)
+		if err != nil {
+			return err
+		}
+
+		var pv = fmt.Sprintf("password%s%d", g.tn, len(*g.vars))
+		*g.vars = append(*g.vars, fmt.Sprintf("%s = %#v", pv, policy))
+
+		g.imports[isPath] = true
+		g.printf("\tif u := %s(%s, %s); len(u) > 0 {\n", fn, val, pv)
+		g.printf("\t\tm = append(m, validator.PasswordMessage(%s, u))\n\t}\n", pv)
+	case func(string, is.EmailPolicy) bool:
+		val, err := str(x, t)
+		if err != nil {
+			return err
+		}
+
+		policy, err := validator.ParseEmailPolicy(strs(tag)...)
+		if err != nil {
+			return err
+		}
+
+		// Zero policy checks addresses like is.Email, which is called
+		// directly.
+		g.imports[isPath] = true
+		if reflect.ValueOf(policy).IsZero() {
+			g.printf("\tif !is.Email(%s) {\n\t\tm = append(m, %s)\n\t}\n", val, msg)
+			return nil
+		}
+
+		var pv = fmt.Sprintf("email%s%d", g.tn, len(*g.vars))
+		*g.vars = append(*g.vars, fmt.Sprintf("%s = %#v", pv, policy))
+		g.printf("\tif !%s(%s, %s) {\n\t\tm = append(m, %s)\n\t}\n", fn, val, pv, msg)
+	default:
+		return fmt.Errorf("unsupported validation function %T", v)
+	}
+	return nil
+}
+
+// chain generates checks that stop at the first failing condition of
+// 'cond', appending message 'msg' formatted with matching 'args'.
+func (g *generator) chain(cond []string, msg string, args []string) {
+	if len(cond) == 0 {
+		return
+	}
+
+	g.imports["fmt"], g.imports[isPath] = true, true
+	for i, c := range cond {
+		if i == 0 {
+			g.printf("\tif %s {\n", c)
+		} else {
+			g.printf(" else if %s {\n", c)
+		}
+		g.printf("\t\tm = append(m, fmt.Sprintf(%s, %s))\n\t}", msg, args[i])
+	}
+	g.printf("\n")
+}
+
+// timeCond generates condition, with optional init statement, that fails
+// if value 'x' of type 't' is not a time or 'fn' returns false for it and
+// parameter 'p'. It returns an error if 't' is neither time nor string.
+func (g *generator) timeCond(x string, t types.Type, fn, p string) (string, error) {
+	g.imports["time"] = true
+
+	if isTime(t) {
+		return fmt.Sprintf("!%s(%s, %s)", fn, x, p), nil
+	}
+
+	val, err := str(x, t)
+	if err != nil {
+		return "", errType(t, "time.Time")
+	}
+	return fmt.Sprintf("t, ok := validator.ParseTime(%s); !ok || !%s(t, %s)", val, fn, p), nil
+}
+
+// isFunc returns qualified name of function 'fn' if it is declared in
+// [is] package, or empty string otherwise.
+func isFunc(fn any) string {
+	var rv = reflect.ValueOf(fn)
+	if rv.Kind() != reflect.Func {
+		return ""
+	}
+
+	var n = runtime.FuncForPC(rv.Pointer()).Name()
+	n, _, _ = strings.Cut(n, "[")
+	if !strings.HasPrefix(n, isPath+".") {
+		return ""
+	}
+	return "is." + strings.TrimPrefix(n, isPath+".")
+}
+
+// unknown returns undefined identifier emitted for unknown tag 'n'.
+func unknown(n string) string {
+	return "unknownValidatorTag_" + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, n)
+}
+
+// isNumber checks if 't' is integer or floating-point type.
+func isNumber(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Kind() != types.Uintptr && b.Info()&(types.IsInteger|types.IsFloat) != 0
+}
+
+// ordered checks if values of type 't' are accepted by
+// [validator.Compare]: numbers, decimal strings and math/big values.
+func ordered(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return isNumber(t) || u.Kind() == types.String
+	case *types.Struct, *types.Pointer:
+		return true
+	default:
+		return false
+	}
+}
+
+// native returns expressions of value 'x' of numeric type 't' and of
+// parameter 'p' that compare like [validator.Compare] does in kind of the
+// value. It returns false if 'p' does not fit in the kind, int and uint
+// are assumed to be 32 bits wide so generated code compiles on every
+// platform.
+func native(x string, t types.Type, p string) (string, string, bool) {
+	if !isNumber(t) {
+		return "", "", false
+	}
+
+	var b = t.Underlying().(*types.Basic)
+
+	var bits = 32
+	switch b.Kind() {
+	case types.Int8, types.Uint8:
+		bits = 8
+	case types.Int16, types.Uint16:
+		bits = 16
+	case types.Int64, types.Uint64, types.Float64:
+		bits = 64
+	}
+
+	switch {
+	case b.Info()&types.IsFloat != 0:
+		pf, err := strconv.ParseFloat(p, bits)
+		if err != nil {
+			return "", "", false
+		}
+		return x, strconv.FormatFloat(pf, 'g', -1, bits), true
+	case b.Info()&types.IsUnsigned != 0:
+		pu, err := strconv.ParseUint(p, 10, bits)
+		if err != nil {
+			return "", "", false
+		}
+		return x, strconv.FormatUint(pu, 10), true
+	default:
+		pi, err := strconv.ParseInt(p, 10, bits)
+		if err != nil {
+			return "", "", false
+		}
+		return x, strconv.FormatInt(pi, 10), true
+	}
+}
+
+// literal returns Go literal of tag parameter 'v'.
+func literal(v any) string {
+	switch v := v.(type) {
+	case float64:
+		return "float64(" + strconv.FormatFloat(v, 'g', -1, 64) + ")"
+	case string:
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprintf("%#v", v)
+	}
+}
+
+// str returns expression converting value 'x' of type 't' into string,
+// named string types are converted like validator does. It returns an
+// error for types converted into text at validation time.
+func str(x string, t types.Type) (string, error) {
+	b, ok := t.Underlying().(*types.Basic)
+	if (!ok || b.Info()&types.IsString == 0) && !isTime(t) && (method(t, "MarshalText") || method(t, "String")) {
+		return "", fmt.Errorf("unsupported type %s: text of encoding.TextMarshaler and fmt.Stringer is converted at validation time", t)
+	}
+
+	if !ok || b.Info()&types.IsString == 0 {
+		return "", errType(t, "string")
+	}
+
+	if types.Identical(t, types.Typ[types.String]) {
+		return x, nil
+	}
+	return "string(" + x + ")", nil
+}
+
+// strs returns parameters of tag 'tag' as strings.
+func strs(tag validator.Tag) []string {
+	var p = make([]string, len(tag.P))
+	for i, tp := range tag.P {
+		p[i], _ = tp.(string)
+	}
+	return p
+}
+
+// isTime checks if 't' is time.Time type.
+func isTime(t types.Type) bool {
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	var o = n.Obj()
+	return o.Pkg() != nil && o.Pkg().Path() == "time" && o.Name() == "Time"
+}
+
+// method checks if method set of type 't' has method named 'n'.
+func method(t types.Type, n string) bool {
+	return types.NewMethodSet(t).Lookup(nil, n) != nil
+}
+
+// errType returns an error for field type 't' not accepted by a tag that
+// validates 'want' values.
+func errType(t types.Type, want string) error {
+	return fmt.Errorf("fail to convert %s to %s", t, want)
+}
+
+// errParam returns an error for tag parameter 'p' that cannot be converted
+// into 'want' type.
+func errParam(p any, want string) error {
+	return fmt.Errorf("fail to convert parameter %v to %s", p, want)
+}
@@ -0,0 +1,85 @@
+// Package example holds structs used to test code generated by
+// zoo-validate-gen.
+package example
+
+import "time"
+
+//go:generate go run github.com/n4x2/zoo/cmd/zoo-validate-gen
+
+// Country is ISO 3166-1 alpha-2 country code.
+type Country string
+
+// Address is a struct with string rules.
+type Address struct {
+	City    string  `json:"city" v:"alpha"`
+	Zip     string  `json:"zip" v:"-|alphanum"`
+	Country Country `json:"country" v:"alpha|uppercase"`
+}
+
+// Customer is a struct with string, numeric and enum rules.
+type Customer struct {
+	ID    string  `json:"id" v:"uuid"`
+	Email string  `json:"email" v:"email|lowercase"`
+	Age   int     `json:"age" v:"gt:17|lte:120"`
+	Score float64 `json:"score,omitempty" v:"range:0,10"`
+	Tier  string  `json:"tier" v:"enum:basic,gold"`
+	Notes string  `json:"-" v:"-"`
+}
+
+// Event is a struct with date-time rules.
+type Event struct {
+	Name    string     `v:"alphadash"`
+	Day     string     `json:"day" v:"datetime:2006-01-02"`
+	Start   time.Time  `json:"start" v:"after:2020-01-01|before:now"`
+	End     *time.Time `json:"end" v:"after:2020-01-01"`
+	Until   *time.Time `json:"until" v:"required|before:now"`
+	Created string     `json:"created" v:"within:24h"`
+	Zone    string     `json:"zone" v:"timezone"`
+}
+
+// Order is a struct with exact numeric and decimal rules.
+type Order struct {
+	ID       int64   `json:"id" v:"gt:9007199254740992"`
+	Quantity uint8   `json:"quantity" v:"range:1,300"`
+	Weight   float32 `json:"weight" v:"lte:0.1"`
+	Total    string  `json:"total" v:"decimal:8,2|gte:0.01"`
+}
+
+// Account is a struct with alternative, negated and expression rules.
+type Account struct {
+	Ref     string `json:"ref" v:"uuid or ulid"`
+	Handle  string `json:"handle" v:"(alpha|lowercase) or not ascii"`
+	Balance int    `json:"balance" v:"not equal:0|lt:1000"`
+	Limit   int    `json:"limit" v:"expr:this >= .balance && len(.Ref) > 0"`
+	Fee     int    `json:"fee" v:"gte:0|expr:this * 10 <= .Limit"`
+}
+
+// Login is a struct with password rules.
+type Login struct {
+	User     string `json:"user" v:"alphanum"`
+	Password string `json:"password" v:"password"`
+	PIN      string `json:"pin" v:"password:min=4,digit,repeat=2,forbid=1234;0000"`
+}
+
+// Contact is a struct with phone number and email rules.
+type Contact struct {
+	Mobile string `json:"mobile" v:"phone:ID"`
+	Office string `json:"office" v:"e164"`
+	Email  string `json:"email" v:"email:html5,deny=example.org,nodisposable"`
+}
+
+// Profile is a struct with pointer fields, nil pointers are absent.
+type Profile struct {
+	Nick    *string    `json:"nick" v:"alpha|lowercase"`
+	Bio     *string    `json:"bio" v:"required|ascii"`
+	Age     *int       `json:"age" v:"gt:0"`
+	Country *Country   `json:"country" v:"uppercase"`
+	Ref     *string    `json:"ref" v:"uuid or ulid"`
+	Seen    *time.Time `json:"seen" v:"before:now"`
+	Limit   *int       `json:"limit" v:"expr:this == nil || this > .age"`
+}
+
+// Note is a struct without validator tags, it is not generated.
+type Note struct {
+	Text string
+}
@@ -0,0 +1,111 @@
+package example
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+// validatable is implemented by generated types.
+type validatable interface {
+	Validate() error
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		past   = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+		recent = time.Now().Add(-time.Hour)
+		future = time.Now().Add(time.Hour)
+	)
+
+	var (
+		jane, ref, empty = "jane", "01ARZ3NDEKTSV4RRFFQ69G5FAV", ""
+		upper, lower     = Country("FR"), Country("fr")
+		zero, ten        = 0, 10
+	)
+
+	tests := []struct {
+		name string
+		v    validatable
+	}{
+		{name: "valid address", v: Address{City: "Paris", Zip: "75001", Country: "FR"}},
+		{name: "invalid address", v: Address{City: "P4ris", Zip: "75-001", Country: "fr"}},
+		{name: "valid customer", v: Customer{
+			ID:    "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			Email: "jane@example.com",
+			Age:   30,
+			Score: 9.5,
+			Tier:  "gold",
+		}},
+		{name: "invalid customer", v: Customer{
+			ID:    "42",
+			Email: "Jane",
+			Age:   150,
+			Score: 11,
+			Tier:  "silver",
+		}},
+		{name: "zero customer", v: Customer{}},
+		{name: "valid event", v: Event{
+			Name:    "launch_day",
+			Day:     "2024-05-01",
+			Start:   recent,
+			End:     &recent,
+			Until:   &past,
+			Created: recent.Format(time.RFC3339),
+			Zone:    "Europe/Paris",
+		}},
+		{name: "invalid event", v: Event{
+			Name:    "launch day",
+			Day:     "01/05/2024",
+			Start:   past,
+			End:     &past,
+			Created: "yesterday",
+			Zone:    "Mars/Olympus",
+		}},
+		{name: "valid order", v: Order{ID: 9007199254740993, Quantity: 3, Weight: 0.1, Total: "19.99"}},
+		{name: "invalid order", v: Order{ID: 9007199254740992, Quantity: 0, Weight: 0.11, Total: "0.001"}},
+		{name: "valid account", v: Account{Ref: "01ARZ3NDEKTSV4RRFFQ69G5FAV", Handle: "jane", Balance: 10, Limit: 20, Fee: 2}},
+		{name: "invalid account", v: Account{Ref: "42", Handle: "Jane", Balance: 0, Limit: -1, Fee: 1}},
+		{name: "valid login", v: Login{User: "jane", Password: "correct-Horse-7-battery", PIN: "2580"}},
+		{name: "invalid login", v: Login{User: "jane", Password: "password", PIN: "1112345"}},
+		{name: "valid contact", v: Contact{Mobile: "0812-3456-7890", Office: "+442079460958", Email: "jane@münchen.de"}},
+		{name: "invalid contact", v: Contact{Mobile: "+442079460958", Office: "020 7946 0958", Email: "jane@mailinator.com"}},
+		{name: "zero event", v: Event{}},
+		{name: "valid profile", v: Profile{Nick: &jane, Bio: &jane, Age: &ten, Country: &upper, Ref: &ref, Seen: &past, Limit: &ten}},
+		{name: "invalid profile", v: Profile{Nick: &ref, Bio: &empty, Age: &zero, Country: &lower, Ref: &jane, Seen: &future, Limit: &zero}},
+		{name: "nil profile", v: Profile{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want, err := validator.New().ValidateStruct(tt.v)
+			if err != nil {
+				t.Fatalf("ValidateStruct() error = %v", err)
+			}
+
+			err = tt.v.Validate()
+			if len(want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var ve *validator.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("Validate() error = %v, want ValidationError", err)
+			}
+
+			if !reflect.DeepEqual(ve.Results, want) {
+				t.Errorf("Validate() results = %v, want %v", ve.Results, want)
+			}
+		})
+	}
+}
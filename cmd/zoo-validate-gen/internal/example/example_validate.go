@@ -0,0 +1,552 @@
+// Code generated by zoo-validate-gen; DO NOT EDIT.
+
+package example
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/n4x2/zoo/is"
+	"github.com/n4x2/zoo/validator"
+)
+
+// Programs and policies of rules of Account.
+var (
+	exprAccount0 = validator.MustParseProgram("this >= .balance && len(.Ref) > 0")
+	exprAccount1 = validator.MustParseProgram("this * 10 <= .Limit")
+)
+
+// Validate validates Account against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Account) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	m = append(m, validator.Or(
+		func() (m []string) {
+			if !is.UUID(x.Ref) {
+				m = append(m, validator.E["uuid"])
+			}
+			return m
+		}(),
+		func() (m []string) {
+			if !is.ULID(x.Ref) {
+				m = append(m, validator.E["ulid"])
+			}
+			return m
+		}(),
+	)...)
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "ref", E: m})
+	}
+
+	m = nil
+	m = append(m, validator.Or(
+		func() (m []string) {
+			if !is.Alpha(x.Handle) {
+				m = append(m, validator.E["alpha"])
+			}
+			if !is.Lowercase(x.Handle) {
+				m = append(m, validator.E["lowercase"])
+			}
+			return m
+		}(),
+		func() (m []string) {
+			m = append(m, validator.Not("ascii", func() (m []string) {
+				if !is.ASCII(x.Handle) {
+					m = append(m, validator.E["ascii"])
+				}
+				return m
+			}())...)
+			return m
+		}(),
+	)...)
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "handle", E: m})
+	}
+
+	m = nil
+	m = append(m, validator.Not("equal:0", func() (m []string) {
+		if !is.Equal(x.Balance, 0) {
+			m = append(m, fmt.Sprintf(validator.E["equal"], "0"))
+		}
+		return m
+	}())...)
+	if !is.LessThan(x.Balance, 1000) {
+		m = append(m, fmt.Sprintf(validator.E["lt"], "1000"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "balance", E: m})
+	}
+
+	m = nil
+	if ok, err := exprAccount0.Eval(x.Limit, func(n string) (any, bool) {
+		switch n {
+		case "balance":
+			return x.Balance, true
+		case "Ref":
+			return x.Ref, true
+		}
+		return nil, false
+	}); err != nil || !ok {
+		m = append(m, fmt.Sprintf(validator.E["expr"], exprAccount0))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "limit", E: m})
+	}
+
+	m = nil
+	if !is.GreaterThanEqual(x.Fee, 0) {
+		m = append(m, fmt.Sprintf(validator.E["gte"], "0"))
+	}
+	if ok, err := exprAccount1.Eval(x.Fee, func(n string) (any, bool) {
+		switch n {
+		case "Limit":
+			return x.Limit, true
+		}
+		return nil, false
+	}); err != nil || !ok {
+		m = append(m, fmt.Sprintf(validator.E["expr"], exprAccount1))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "fee", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Validate validates Address against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Address) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.Alpha(x.City) {
+		m = append(m, validator.E["alpha"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "city", E: m})
+	}
+
+	m = nil
+	if !is.AlphaNumeric(x.Zip) {
+		m = append(m, validator.E["alphanum"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "zip", E: m})
+	}
+
+	m = nil
+	if !is.Alpha(string(x.Country)) {
+		m = append(m, validator.E["alpha"])
+	}
+	if !is.Uppercase(string(x.Country)) {
+		m = append(m, validator.E["uppercase"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "country", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Programs and policies of rules of Contact.
+var (
+	emailContact0 = is.EmailPolicy{Mode: "html5", Allow: []string(nil), Deny: []string{"example.org"}, NoDisposable: true}
+)
+
+// Validate validates Contact against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Contact) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.Phone(x.Mobile, "ID") {
+		m = append(m, fmt.Sprintf(validator.E["phone"], "ID"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "mobile", E: m})
+	}
+
+	m = nil
+	if !is.E164(x.Office) {
+		m = append(m, validator.E["e164"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "office", E: m})
+	}
+
+	m = nil
+	if !is.EmailAddress(x.Email, emailContact0) {
+		m = append(m, validator.E["email"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "email", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Validate validates Customer against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Customer) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.UUID(x.ID) {
+		m = append(m, validator.E["uuid"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "id", E: m})
+	}
+
+	m = nil
+	if !is.Email(x.Email) {
+		m = append(m, validator.E["email"])
+	}
+	if !is.Lowercase(x.Email) {
+		m = append(m, validator.E["lowercase"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "email", E: m})
+	}
+
+	m = nil
+	if !is.GreaterThan(x.Age, 17) {
+		m = append(m, fmt.Sprintf(validator.E["gt"], "17"))
+	}
+	if !is.LessThanEqual(x.Age, 120) {
+		m = append(m, fmt.Sprintf(validator.E["lte"], "120"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "age", E: m})
+	}
+
+	m = nil
+	if !is.Range(0, 10, x.Score) {
+		m = append(m, fmt.Sprintf(validator.E["range"], "0", "10"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "score", E: m})
+	}
+
+	m = nil
+	if !is.Contain([]string{"basic", "gold"}, x.Tier) {
+		m = append(m, fmt.Sprintf(validator.E["enum"], x.Tier))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "tier", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Validate validates Event against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Event) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.AlphaDash(x.Name) {
+		m = append(m, validator.E["alphadash"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "Name", E: m})
+	}
+
+	m = nil
+	if !is.Date(x.Day, "2006-01-02") {
+		m = append(m, fmt.Sprintf(validator.E["datetime"], "2006-01-02"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "day", E: m})
+	}
+
+	m = nil
+	if !is.After(x.Start, time.Unix(1577836800, 0)) {
+		m = append(m, fmt.Sprintf(validator.E["after"], "2020-01-01"))
+	}
+	if !is.Before(x.Start, time.Now()) {
+		m = append(m, fmt.Sprintf(validator.E["before"], "now"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "start", E: m})
+	}
+
+	m = nil
+	if x.End != nil {
+		if !is.After(*x.End, time.Unix(1577836800, 0)) {
+			m = append(m, fmt.Sprintf(validator.E["after"], "2020-01-01"))
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "end", E: m})
+	}
+
+	m = nil
+	if !is.Present(x.Until) {
+		m = append(m, validator.E["required"])
+	}
+	if x.Until != nil {
+		if !is.Before(*x.Until, time.Now()) {
+			m = append(m, fmt.Sprintf(validator.E["before"], "now"))
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "until", E: m})
+	}
+
+	m = nil
+	if t, ok := validator.ParseTime(x.Created); !ok || !is.Within(t, time.Duration(86400000000000)) {
+		m = append(m, fmt.Sprintf(validator.E["within"], time.Duration(86400000000000)))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "created", E: m})
+	}
+
+	m = nil
+	if !is.Timezone(x.Zone) {
+		m = append(m, validator.E["timezone"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "zone", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Programs and policies of rules of Login.
+var (
+	passwordLogin0 = is.PasswordPolicy{MinLength: 12, Upper: true, Lower: true, Digit: true, Symbol: true, MaxRepeat: 3, Forbidden: []string{"password", "qwerty", "letmein", "123456", "abcdef"}, MinEntropy: 60}
+	passwordLogin1 = is.PasswordPolicy{MinLength: 4, Upper: false, Lower: false, Digit: true, Symbol: false, MaxRepeat: 2, Forbidden: []string{"1234", "0000"}, MinEntropy: 0}
+)
+
+// Validate validates Login against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Login) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.AlphaNumeric(x.User) {
+		m = append(m, validator.E["alphanum"])
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "user", E: m})
+	}
+
+	m = nil
+	if u := is.Password(x.Password, passwordLogin0); len(u) > 0 {
+		m = append(m, validator.PasswordMessage(passwordLogin0, u))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "password", E: m})
+	}
+
+	m = nil
+	if u := is.Password(x.PIN, passwordLogin1); len(u) > 0 {
+		m = append(m, validator.PasswordMessage(passwordLogin1, u))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "pin", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Validate validates Order against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Order) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if !is.GreaterThan(x.ID, 9007199254740992) {
+		m = append(m, fmt.Sprintf(validator.E["gt"], "9007199254740992"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "id", E: m})
+	}
+
+	m = nil
+	if !func() bool {
+		lo, err := validator.Compare(x.Quantity, "1")
+		if err != nil {
+			return false
+		}
+		hi, err := validator.Compare(x.Quantity, "300")
+		return err == nil && is.Range(-lo, -hi, 0)
+	}() {
+		m = append(m, fmt.Sprintf(validator.E["range"], "1", "300"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "quantity", E: m})
+	}
+
+	m = nil
+	if !is.LessThanEqual(x.Weight, 0.1) {
+		m = append(m, fmt.Sprintf(validator.E["lte"], "0.1"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "weight", E: m})
+	}
+
+	m = nil
+	if !is.Decimal(x.Total, 8, 2) {
+		m = append(m, fmt.Sprintf(validator.E["decimal"], 8, 2))
+	}
+	if c, err := validator.Compare(x.Total, "0.01"); err != nil || !is.GreaterThanEqual(0, -c) {
+		m = append(m, fmt.Sprintf(validator.E["gte"], "0.01"))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "total", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Programs and policies of rules of Profile.
+var (
+	exprProfile0 = validator.MustParseProgram("this == nil || this > .age")
+)
+
+// Validate validates Profile against its validator tags, see
+// [validator.Validator.ValidateStruct]. It returns
+// [validator.ValidationError] if any validation error encountered.
+func (x Profile) Validate() error {
+	var res []validator.Result
+	var m []string
+
+	m = nil
+	if x.Nick != nil {
+		if !is.Alpha(*x.Nick) {
+			m = append(m, validator.E["alpha"])
+		}
+	}
+	if x.Nick != nil {
+		if !is.Lowercase(*x.Nick) {
+			m = append(m, validator.E["lowercase"])
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "nick", E: m})
+	}
+
+	m = nil
+	if !is.Present(x.Bio) {
+		m = append(m, validator.E["required"])
+	}
+	if x.Bio != nil {
+		if !is.ASCII(*x.Bio) {
+			m = append(m, validator.E["ascii"])
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "bio", E: m})
+	}
+
+	m = nil
+	if x.Age != nil {
+		if !is.GreaterThan(*x.Age, 0) {
+			m = append(m, fmt.Sprintf(validator.E["gt"], "0"))
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "age", E: m})
+	}
+
+	m = nil
+	if x.Country != nil {
+		if !is.Uppercase(string(*x.Country)) {
+			m = append(m, validator.E["uppercase"])
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "country", E: m})
+	}
+
+	m = nil
+	m = append(m, validator.Or(
+		func() (m []string) {
+			if x.Ref != nil {
+				if !is.UUID(*x.Ref) {
+					m = append(m, validator.E["uuid"])
+				}
+			}
+			return m
+		}(),
+		func() (m []string) {
+			if x.Ref != nil {
+				if !is.ULID(*x.Ref) {
+					m = append(m, validator.E["ulid"])
+				}
+			}
+			return m
+		}(),
+	)...)
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "ref", E: m})
+	}
+
+	m = nil
+	if x.Seen != nil {
+		if !is.Before(*x.Seen, time.Now()) {
+			m = append(m, fmt.Sprintf(validator.E["before"], "now"))
+		}
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "seen", E: m})
+	}
+
+	m = nil
+	if ok, err := exprProfile0.Eval(x.Limit, func(n string) (any, bool) {
+		switch n {
+		case "age":
+			return x.Age, true
+		}
+		return nil, false
+	}); err != nil || !ok {
+		m = append(m, fmt.Sprintf(validator.E["expr"], exprProfile0))
+	}
+	if len(m) > 0 {
+		res = append(res, validator.Result{F: "limit", E: m})
+	}
+
+	if len(res) > 0 {
+		return &validator.ValidationError{Results: res}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Command zoo-validate-gen generates reflection-free validation methods from
+// validator tags.
+//
+// For every struct type of a package that has validator tags it writes a
+// method
+//
+//	func (x T) Validate() error
+//
+// that calls functions of package [is] directly and returns
+// [validator.ValidationError] holding the same results as
+// [validator.Validator.ValidateStruct] with default rules and messages.
+// Nil pointers are absent and only validated by rules such as required.
+// Values unwrapped by validator at validation time are not supported: types
+// implementing driver.Valuer, text of encoding.TextMarshaler and
+// fmt.Stringer for string rules, and types of registered unwrappers, which
+// are validated as is.
+// Unknown tags are emitted as references to undefined identifiers, so the
+// generated code fails to compile until the tag is fixed. Tags that cannot
+// be applied to the field type are reported by the command. Modifier and
+// default tags are not applied by generated methods, fields having only
+// modifiers or defaults are not validated.
+//
+// The command is installed from a checkout of the repository, as its
+// module replaces zoo with the checkout and requires Go 1.25 for
+// golang.org/x/tools, while package zoo itself requires Go 1.21:
+//
+//	git clone https://github.com/n4x2/zoo
+//	cd zoo/cmd && go install ./zoo-validate-gen
+//
+// Usage:
+//
+//	//go:generate zoo-validate-gen -type=Customer,Order
+//
+// Flags:
+//
+//	-type    comma-separated list of struct type names; default all tagged structs
+//	-output  output file name; default <package>_validate.go
+//
+// [is]: https://pkg.go.dev/github.com/n4x2/zoo/is
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	typeNames = flag.String("type", "", "comma-separated list of struct type names")
+	output    = flag.String("output", "", "output file name")
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("zoo-validate-gen: ")
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: zoo-validate-gen [flags] [package]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	var pattern = "."
+	if flag.NArg() > 0 {
+		pattern = flag.Arg(0)
+	}
+
+	pkg, err := load(pattern, packages.NeedName|packages.NeedFiles, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var path = *output
+	if path == "" {
+		path = strings.ToLower(pkg.Name) + "_validate.go"
+	}
+
+	if !filepath.IsAbs(path) && len(pkg.GoFiles) > 0 {
+		path = filepath.Join(filepath.Dir(pkg.GoFiles[0]), path)
+	}
+
+	// Previous output is replaced by an empty file, so it cannot break
+	// type checking of the package.
+	pkg, err = load(pattern, packages.NeedName|packages.NeedFiles|packages.NeedTypes, map[string][]byte{
+		path: []byte("package " + pkg.Name + "\n"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var names []string
+	if *typeNames != "" {
+		names = strings.Split(*typeNames, ",")
+	}
+
+	src, err := generate(pkg.Types, names)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// load loads information requested by 'mode' of single package matching
+// 'pattern', with file contents replaced by 'overlay'.
+func load(pattern string, mode packages.LoadMode, overlay map[string][]byte) (*packages.Package, error) {
+	var cfg = &packages.Config{Mode: mode, Overlay: overlay}
+
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("%d packages found for %s", len(pkgs), pattern)
+	}
+
+	if len(pkgs[0].Errors) > 0 {
+		return nil, pkgs[0].Errors[0]
+	}
+	return pkgs[0], nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// check type checks package source 'src'.
+func check(t *testing.T, src string) *types.Package {
+	t.Helper()
+
+	var fset = token.NewFileSet()
+	f, err := parser.ParseFile(fset, "src.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := new(types.Config).Check("src", fset, []*ast.File{f}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pkg
+}
+
+func TestGenerateExample(t *testing.T) {
+	t.Parallel()
+
+	const path = "internal/example/example_validate.go"
+
+	pkg, err := load("./internal/example", packages.NeedName|packages.NeedFiles|packages.NeedTypes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := generate(pkg.Types, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is stale, run go generate:\n%s", path, got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		src   string
+		types []string
+		want  []string
+		err   string
+	}{
+		{
+			name: "unknown tag",
+			src:  "package src\ntype T struct { Name string `v:\"alpah|alpha\"` }",
+			want: []string{"_ = unknownValidatorTag_alpah", "is.Alpha(x.Name)"},
+		},
+		{
+			name: "skip and no params",
+			src:  "package src\ntype T struct { Age int `v:\"-|gt\"` }",
+			want: []string{"func (x T) Validate() error {\n\treturn nil\n}"},
+		},
+		{
+			name:  "selected types",
+			src:   "package src\ntype A struct { N string `v:\"alpha\"` }\ntype B struct { N string `v:\"alpha\"` }",
+			types: []string{"B"},
+			want:  []string{"func (x B) Validate() error"},
+		},
+		{
+			name: "type mismatch",
+			src:  "package src\ntype T struct { Age int `v:\"alpha\"` }",
+			err:  "T.Age: tag alpha: fail to convert int to string",
+		},
+		{
+			name: "not a number",
+			src:  "package src\ntype T struct { Ok bool `v:\"gt:1\"` }",
+			err:  "T.Ok: tag gt: fail to convert bool to number",
+		},
+		{
+			name: "native number",
+			src:  "package src\ntype T struct { N int8 `v:\"range:-5,5\"`; F float32 `v:\"lt:1.5\"` }",
+			want: []string{"is.Range(-5, 5, x.N)", "is.LessThan(x.F, 1.5)"},
+		},
+		{
+			name: "exact number",
+			src:  "package src\ntype T struct { N int8 `v:\"gt:200\"`; S string `v:\"lte:0.5\"` }",
+			want: []string{`validator.Compare(x.N, "200")`, `validator.Compare(x.S, "0.5")`},
+		},
+		{
+			name: "expression",
+			src:  "package src\ntype T struct { A int `json:\"a\" v:\"expr:this < .b || !ascii(.C)\"`; B int `json:\"b\" v:\"-\"`; C string `v:\"-\"` }",
+			want: []string{`exprT0 = validator.MustParseProgram("this < .b || !ascii(.C)")`, "case \"b\":\n\t\t\treturn x.B, true"},
+		},
+		{
+			name: "expression unknown field",
+			src:  "package src\ntype T struct { A int `v:\"expr:this < .B\"` }",
+			err:  "T.A: tag expr: unknown field .B",
+		},
+		{
+			name: "password",
+			src:  "package src\ntype T struct { P string `v:\"password:min=8,digit\"` }",
+			want: []string{"passwordT0 = is.PasswordPolicy{MinLength: 8,", "validator.PasswordMessage(passwordT0, u)"},
+		},
+		{
+			name: "invalid password policy",
+			src:  "package src\ntype T struct { P string `v:\"password:min=a\"` }",
+			err:  "T.P: tag password: invalid password policy: min=a",
+		},
+		{
+			name: "email policy",
+			src:  "package src\ntype T struct { A string `v:\"email\"`; B string `v:\"email:simple,allow=example.com\"` }",
+			want: []string{"!is.Email(x.A)", `emailT0 = is.EmailPolicy{Mode: "simple", Allow: []string{"example.com"}`, "!is.EmailAddress(x.B, emailT0)"},
+		},
+		{
+			name: "named string",
+			src:  "package src\ntype S string\ntype T struct { Name S `v:\"alpha\"` }",
+			want: []string{"is.Alpha(string(x.Name))"},
+		},
+		{
+			name: "nil pointer",
+			src:  "package src\ntype T struct { Age *int `v:\"required|gt:0\"` }",
+			want: []string{"!is.Present(x.Age)", "if x.Age != nil {\n\t\tif !is.GreaterThan(*x.Age, 0) {"},
+		},
+		{
+			name: "valuer",
+			src:  "package src\ntype N struct{ V int64 }\nfunc (n N) Value() (any, error) { return n.V, nil }\ntype T struct { Age *N `v:\"gt:0\"`; Skip N `v:\"-\"` }",
+			err:  "T.Age: unsupported type *src.N: values of driver.Valuer are unwrapped at validation time",
+		},
+		{
+			name: "stringer",
+			src:  "package src\ntype L struct{}\nfunc (L) String() string { return \"low\" }\ntype T struct { Level L `v:\"lowercase\"` }",
+			err:  "T.Level: tag lowercase: unsupported type src.L: text of encoding.TextMarshaler and fmt.Stringer is converted at validation time",
+		},
+		{
+			name: "invalid param",
+			src:  "package src\ntype T struct { Age int `v:\"gt:a\"` }",
+			err:  "T.Age: tag gt only accept numeric parameter",
+		},
+		{
+			name: "modifiers",
+			src:  "package src\ntype T struct { Name string `v:\"alpha\" mod:\"trim\"`; Note string `mod:\"collapse\"` }",
+			want: []string{"is.Alpha(x.Name)"},
+		},
+		{
+			name: "defaults",
+			src:  "package src\ntype T struct { Age int `v:\"gte:18\" default:\"18\"`; Tags []string `default:\"a,b\"` }",
+			want: []string{"is.GreaterThanEqual(x.Age, 18)"},
+		},
+		{
+			name: "missing tag",
+			src:  "package src\ntype T struct { Name string `v:\"alpha\"`; Age int }",
+			err:  "T.Age: missing validator tag",
+		},
+		{
+			name: "unexported field",
+			src:  "package src\ntype T struct { Name string `v:\"alpha\"`; age int }",
+			err:  "T.age: unexported field encountered",
+		},
+		{
+			name:  "not a struct",
+			src:   "package src\ntype T int",
+			types: []string{"T"},
+			err:   "type T is not a struct",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generate(check(t, tt.src), tt.types)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("generate() error = %v, want %s", err, tt.err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("generate() error = %v", err)
+			}
+
+			for _, w := range tt.want {
+				if !strings.Contains(string(got), w) {
+					t.Errorf("generate() = %s, want containing %s", got, w)
+				}
+			}
+
+			if tt.types != nil && strings.Contains(string(got), "func (x A)") {
+				t.Errorf("generate() = %s, want only %v", got, tt.types)
+			}
+		})
+	}
+}
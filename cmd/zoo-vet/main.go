@@ -0,0 +1,27 @@
+// Command zoo-vet reports invalid validator tags, see package
+// [github.com/n4x2/zoo/validator/analyzer].
+//
+// The command is installed from a checkout of the repository like
+// zoo-validate-gen, see its documentation:
+//
+//	git clone https://github.com/n4x2/zoo
+//	cd zoo/cmd && go install ./zoo-vet
+//
+// Usage:
+//
+//	go vet -vettool=$(which zoo-vet) ./...
+//
+// Names of rules, aliases, expression functions and modifiers registered
+// at runtime are accepted with the -vtag.rules, -vtag.funcs and
+// -vtag.mods flags, e.g. -vtag.rules=even,username.
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/unitchecker"
+
+	"github.com/n4x2/zoo/validator/analyzer"
+)
+
+func main() {
+	unitchecker.Main(analyzer.Analyzer)
+}
@@ -1,3 +1,9 @@
 module github.com/n4x2/zoo
 
 go 1.21
+
+require (
+	golang.org/x/net v0.35.0
+	golang.org/x/text v0.22.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,190 @@
+package is
+
+import (
+	_ "embed"
+	"net/mail"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	"github.com/n4x2/zoo/regex"
+)
+
+// Modes of [EmailPolicy].
+const (
+	EmailRFC5322 = "rfc5322" // Address specification of RFC 5322, parsed by net/mail.
+	EmailHTML5   = "html5"   // Valid email address of WHATWG HTML standard.
+	EmailSimple  = "simple"  // Local part and dotted domain without spaces.
+)
+
+// Maximum lengths of email addresses in octets, as of RFC 5321.
+const (
+	EmailLocalMax = 64  // Maximum length of local part.
+	EmailMax      = 254 // Maximum length of address.
+)
+
+// EmailPolicy holds syntax and domain requirements of email addresses
+// checked by [EmailAddress]. Zero value checks addresses like [Email].
+type EmailPolicy struct {
+	Mode         string   // One of modes such as [EmailHTML5], or [Email] if empty.
+	Allow        []string // Allowed domains including subdomains, any if empty.
+	Deny         []string // Denied domains including subdomains.
+	NoDisposable bool     // Rejects domains of [DisposableDomains].
+}
+
+//go:embed disposable.txt
+var disposable string
+
+// DisposableDomains holds domains of disposable email providers checked
+// by [DisposableEmail], loaded from a bundled list. Domains added to it
+// must be lowercase ASCII.
+var DisposableDomains = func() map[string]bool {
+	var m = make(map[string]bool)
+	for _, l := range strings.Split(disposable, "\n") {
+		if l = strings.TrimSpace(l); l != "" && !strings.HasPrefix(l, "#") {
+			m[l] = true
+		}
+	}
+	return m
+}()
+
+// EmailAddress checks if the value is email address valid in mode of
+// policy 'p', with local part of at most [EmailLocalMax] and address of
+// at most [EmailMax] octets, and domain allowed by the policy.
+// Internationalized domain names are checked and matched in their ASCII
+// form, e.g. "münchen.de" as "xn--mnchen-3ya.de".
+func EmailAddress(v string, p EmailPolicy) bool {
+	local, domain, ok := splitEmail(v)
+	if !ok {
+		return false
+	}
+
+	var ascii = domain
+	if !strings.HasPrefix(domain, "[") {
+		d, err := idna.Lookup.ToASCII(domain)
+		if err != nil {
+			return false
+		}
+		ascii = d
+	}
+
+	if len(local) > EmailLocalMax || len(local)+1+len(ascii) > EmailMax {
+		return false
+	}
+
+	switch p.Mode {
+	case "":
+		ok = regex.Email.MatchString(v)
+	case EmailRFC5322:
+		a, err := mail.ParseAddress(v)
+		// Only bare address specification is accepted, without display
+		// name or angle brackets.
+		ok = err == nil && a.Name == "" && !strings.HasSuffix(v, ">") && strings.TrimSpace(v) == v
+	case EmailHTML5:
+		ok = regex.EmailHTML5.MatchString(local + "@" + ascii)
+	case EmailSimple:
+		ok = !strings.ContainsFunc(local, space) && strings.Contains(ascii, ".") &&
+			!strings.HasPrefix(ascii, ".") && !strings.HasSuffix(ascii, ".") && !strings.Contains(ascii, "..")
+	default:
+		ok = false
+	}
+
+	switch {
+	case !ok:
+		return false
+	case listed(ascii, p.Deny):
+		return false
+	case len(p.Allow) > 0 && !listed(ascii, p.Allow):
+		return false
+	case p.NoDisposable && parents(ascii, disposableDomain):
+		return false
+	}
+	return true
+}
+
+// DisposableEmail checks if domain of email address 'v' or its parent is
+// listed in [DisposableDomains].
+func DisposableEmail(v string) bool {
+	_, domain, ok := splitEmail(v)
+	if !ok {
+		return false
+	}
+
+	d, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return false
+	}
+	return parents(d, disposableDomain)
+}
+
+// disposableDomain checks if ASCII domain 'd' is listed in
+// [DisposableDomains].
+func disposableDomain(d string) bool {
+	return DisposableDomains[d]
+}
+
+// NormalizeEmail converts email address 'v' into canonical form: domain is
+// lowercased and converted into ASCII, and subaddress of plus addressing
+// is removed, e.g. "Jane+News@Example.COM" into "Jane@example.com". It
+// returns false if 'v' is not valid address of [EmailRFC5322] mode.
+func NormalizeEmail(v string) (string, bool) {
+	if !EmailAddress(v, EmailPolicy{Mode: EmailRFC5322}) {
+		return "", false
+	}
+
+	local, domain, _ := splitEmail(v)
+	if d, err := idna.Lookup.ToASCII(domain); err == nil {
+		domain = d
+	}
+
+	if !strings.HasPrefix(local, `"`) {
+		if l, _, _ := strings.Cut(local, "+"); l != "" {
+			local = l
+		}
+	}
+	return local + "@" + domain, true
+}
+
+// splitEmail splits email address 'v' at the last "@" into local part
+// and domain. It returns false if either is empty.
+func splitEmail(v string) (string, string, bool) {
+	var i = strings.LastIndexByte(v, '@')
+	if i <= 0 || i == len(v)-1 {
+		return "", "", false
+	}
+	return v[:i], v[i+1:], true
+}
+
+// listed checks if ASCII domain 'd' or its parent is one of 'domains',
+// which may be internationalized domain names.
+func listed(d string, domains []string) bool {
+	return parents(d, func(d string) bool {
+		for _, x := range domains {
+			if a, err := idna.Lookup.ToASCII(x); err == nil && a == d || strings.EqualFold(x, d) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// parents checks if 'fn' returns true for domain 'd' or any of its parent
+// domains.
+func parents(d string, fn func(string) bool) bool {
+	for {
+		if fn(d) {
+			return true
+		}
+
+		_, parent, ok := strings.Cut(d, ".")
+		if !ok {
+			return false
+		}
+		d = parent
+	}
+}
+
+// space checks if 'r' is ASCII space or control character.
+func space(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
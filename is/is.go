@@ -4,11 +4,17 @@ package is
 import (
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/n4x2/zoo/constraints"
 	"github.com/n4x2/zoo/regex"
 )
 
+// After checks if 'v' is after 'p'.
+func After(v, p time.Time) bool {
+	return v.After(p)
+}
+
 // Alpha checks if the value is letters a-z or A-Z.
 func Alpha(v string) bool {
 	return regex.Alpha.MatchString(v)
@@ -30,6 +36,11 @@ func ASCII(v string) bool {
 	return regex.ASCII.MatchString(v)
 }
 
+// Before checks if 'v' is before 'p'.
+func Before(v, p time.Time) bool {
+	return v.Before(p)
+}
+
 // Bool checks if the value is a boolean.
 func Bool(v interface{}) bool {
 	_, ok := v.(bool)
@@ -62,8 +73,41 @@ func ContainOneOf[S comparable](s []S, ss []S) bool {
 	return false
 }
 
-// Email checks if the value is valid email.
+// Date checks if the value is a date-time formatted according to
+// 'layout', see [time.Layout] for the layout format.
+func Date(v, layout string) bool {
+	_, err := time.Parse(layout, v)
+	return err == nil
+}
+
+// Decimal checks if 'v' is a decimal number string with at most
+// 'precision' digits, of which at most 'scale' are after the decimal point,
+// like SQL DECIMAL(precision, scale). Leading zeros of the integer part are
+// not counted.
+func Decimal(v string, precision, scale int) bool {
+	if !regex.Numeric.MatchString(v) {
+		return false
+	}
+
+	ip, fp, _ := strings.Cut(strings.TrimLeft(v, "+-"), ".")
+	ip = strings.TrimLeft(ip, "0")
+	return len(fp) <= scale && len(ip) <= precision-scale
+}
+
+// Duration checks if the value is a valid duration string such as
+// "300ms" or "1h30m".
+func Duration(v string) bool {
+	_, err := time.ParseDuration(v)
+	return err == nil
+}
+
+// Email checks if the value is valid email, with local part of at most
+// [EmailLocalMax] and address of at most [EmailMax] octets.
 func Email(v string) bool {
+	var i = strings.LastIndexByte(v, '@')
+	if i > EmailLocalMax || len(v) > EmailMax {
+		return false
+	}
 	return regex.Email.MatchString(v)
 }
 
@@ -144,11 +188,32 @@ func Numeric(v string) bool {
 	return regex.Numeric.MatchString(v)
 }
 
+// Present checks if the value is present: not nil, not a nil pointer or
+// interface, and not an empty string, slice, map or array.
+func Present(v interface{}) bool {
+	var rv = reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Pointer, reflect.Interface:
+		return !rv.IsNil()
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() > 0
+	}
+	return true
+}
+
 // Range checks if 'v' in range 'b' and 'e'.
 func Range[T constraints.Number](b, e, v T) bool {
 	return v >= b && v <= e
 }
 
+// RFC3339 checks if the value is a valid RFC 3339 date-time.
+func RFC3339(v string) bool {
+	_, err := time.Parse(time.RFC3339, v)
+	return err == nil
+}
+
 // Rune checks if the value is a rune.
 func Rune(v interface{}) bool {
 	_, ok := v.(rune)
@@ -173,6 +238,18 @@ func Struct(v interface{}) bool {
 	return s.Kind() == reflect.Struct
 }
 
+// Timezone checks if the value is a valid IANA time zone name such as
+// "Asia/Jakarta". It relies on the time zone database available on the
+// system or embedded with the time/tzdata package.
+func Timezone(v string) bool {
+	if v == "" || v == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(v)
+	return err == nil
+}
+
 // Uint checks if the value is an unsigned integer.
 func Uint(v interface{}) bool {
 	switch v.(type) {
@@ -197,3 +274,13 @@ func Uppercase(v string) bool {
 func UUID(v string) bool {
 	return regex.UUID.MatchString(v)
 }
+
+// Within checks if 'v' is within 'd' from current time, either in the
+// past or in the future.
+func Within(v time.Time, d time.Duration) bool {
+	var diff = time.Since(v)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= d
+}
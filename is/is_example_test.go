@@ -3,10 +3,19 @@ package is_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/n4x2/zoo/is"
 )
 
+func ExampleAfter() {
+	var t = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	fmt.Println(is.After(t, t.Add(-time.Hour)))
+	// Output:
+	// true
+}
+
 func ExampleAlpha() {
 	t := []string{"orange", "I like apple!"}
 	for _, v := range t {
@@ -47,6 +56,14 @@ func ExampleASCII() {
 	// true
 }
 
+func ExampleBefore() {
+	var t = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	fmt.Println(is.Before(t, t.Add(-time.Hour)))
+	// Output:
+	// false
+}
+
 func ExampleBool() {
 	s := []interface{}{
 		false,
@@ -77,6 +94,14 @@ func ExampleByte() {
 	// false
 }
 
+func ExampleCallingCode() {
+	fmt.Println(is.CallingCode("ID"))
+	fmt.Println(is.CallingCode("XX"))
+	// Output:
+	// 62 true
+	//  false
+}
+
 func ExampleContain() {
 	var s = []int{1, 2, 3, 100, 99}
 	var a, b = 100, 88
@@ -102,6 +127,65 @@ func ExampleContainOneOf() {
 	// false
 }
 
+func ExampleDate() {
+	examples := []string{"2024-02-29", "2023-02-29", "29/02/2024"}
+
+	for _, v := range examples {
+		fmt.Println(is.Date(v, time.DateOnly))
+	}
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleDecimal() {
+	examples := []string{"1234.50", "-0.99", "12345.5", "1.999", "1e3"}
+
+	for _, v := range examples {
+		fmt.Println(is.Decimal(v, 6, 2))
+	}
+	// Output:
+	// true
+	// true
+	// false
+	// false
+	// false
+}
+
+func ExampleDisposableEmail() {
+	fmt.Println(is.DisposableEmail("jane@mailinator.com"))
+	fmt.Println(is.DisposableEmail("jane@example.com"))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleDuration() {
+	examples := []string{"1h30m", "300ms", "10"}
+
+	for _, v := range examples {
+		fmt.Println(is.Duration(v))
+	}
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func ExampleE164() {
+	t := []string{"+6281234567890", "+62812", "+255712345678", "+442079460958123", "081234567890"}
+	for _, v := range t {
+		fmt.Println(is.E164(v))
+	}
+	// Output:
+	// true
+	// false
+	// true
+	// false
+	// false
+}
+
 func ExampleEmail() {
 	examples := []string{
 		"user@example.com",
@@ -118,6 +202,27 @@ func ExampleEmail() {
 	// true
 }
 
+func ExampleEmailAddress() {
+	t := []string{`"jane doe"@example.com`, "jane@localhost", "jane@münchen.de", "jane@yopmail.com"}
+	for _, m := range []string{is.EmailRFC5322, is.EmailHTML5, is.EmailSimple} {
+		var ok []bool
+		for _, v := range t {
+			ok = append(ok, is.EmailAddress(v, is.EmailPolicy{Mode: m, NoDisposable: true}))
+		}
+		fmt.Println(m, ok)
+	}
+
+	p := is.EmailPolicy{Allow: []string{"example.com"}, Deny: []string{"old.example.com"}}
+	fmt.Println(is.EmailAddress("jane@mail.example.com", p))
+	fmt.Println(is.EmailAddress("jane@old.example.com", p))
+	// Output:
+	// rfc5322 [true true true false]
+	// html5 [false true true false]
+	// simple [false false true false]
+	// true
+	// false
+}
+
 func ExampleEqual() {
 	var a, b = 1, 2
 	var c, d = [3]byte{'a', 'b', 'c'}, [3]byte{'c', 'b', 'a'}
@@ -228,6 +333,28 @@ func ExampleLowercase() {
 	// true
 }
 
+func ExampleNormalizeEmail() {
+	fmt.Println(is.NormalizeEmail("Jane+News@Example.COM"))
+	fmt.Println(is.NormalizeEmail("jane@münchen.de"))
+	fmt.Println(is.NormalizeEmail("Jane <jane@example.com>"))
+	// Output:
+	// Jane@example.com true
+	// jane@xn--mnchen-3ya.de true
+	//  false
+}
+
+func ExampleNormalizePhone() {
+	fmt.Println(is.NormalizePhone("0812-3456-7890", "ID"))
+	fmt.Println(is.NormalizePhone("(415) 555-2671", "US"))
+	fmt.Println(is.NormalizePhone("0062 812 3456 7890", "ID"))
+	fmt.Println(is.NormalizePhone("0812", "ID"))
+	// Output:
+	// +6281234567890 true
+	// +14155552671 true
+	// +6281234567890 true
+	//  false
+}
+
 func ExampleNumber() {
 	t := []string{"3", "3.14"}
 	for _, v := range t {
@@ -250,6 +377,50 @@ func ExampleNumeric() {
 	// true
 }
 
+func ExamplePassword() {
+	p := is.PasswordPolicy{MinLength: 8, Upper: true, Digit: true, MaxRepeat: 2}
+	fmt.Println(is.Password("Kettle42", p))
+	fmt.Println(is.Password("kettle", p))
+	fmt.Println(is.Password("Keeettle99", p))
+	// Output:
+	// []
+	// [length upper digit]
+	// [repeat]
+}
+
+func ExamplePasswordEntropy() {
+	fmt.Printf("%.1f\n", is.PasswordEntropy("kettle"))
+	fmt.Printf("%.1f\n", is.PasswordEntropy("Kettle42!"))
+	// Output:
+	// 28.2
+	// 59.1
+}
+
+func ExamplePhone() {
+	fmt.Println(is.Phone("0812 3456 7890", "ID"))
+	fmt.Println(is.Phone("+6281234567890", "ID"))
+	fmt.Println(is.Phone("+442079460958", "ID"))
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func ExamplePresent() {
+	var p *int
+	for _, v := range []any{nil, p, "", []string{}, "a", 0} {
+		fmt.Println(is.Present(v))
+	}
+
+	// Output:
+	// false
+	// false
+	// false
+	// false
+	// true
+	// true
+}
+
 func ExampleRange() {
 	fmt.Println(is.Range('a', 'z', 'd'))
 	fmt.Println(is.Range('a', 'z', 'H'))
@@ -263,6 +434,17 @@ func ExampleRange() {
 	// true
 }
 
+func ExampleRFC3339() {
+	examples := []string{"2024-01-01T10:00:00+07:00", "2024-01-01 10:00:00"}
+
+	for _, v := range examples {
+		fmt.Println(is.RFC3339(v))
+	}
+	// Output:
+	// true
+	// false
+}
+
 func ExampleRune() {
 	var r = rune('a')
 	fmt.Println(is.Rune(r))
@@ -295,6 +477,17 @@ type Example struct {
 	A, B string
 }
 
+func ExampleStrongPassword() {
+	t := []string{"Password123!", "correct-Horse-7-battery", "aaaaBBBB1111!!!!"}
+	for _, v := range t {
+		fmt.Println(is.StrongPassword(v))
+	}
+	// Output:
+	// false
+	// true
+	// false
+}
+
 func ExampleStruct() {
 	var s Example
 	fmt.Println(is.Struct(s))
@@ -303,6 +496,19 @@ func ExampleStruct() {
 	// true
 }
 
+func ExampleTimezone() {
+	examples := []string{"Asia/Jakarta", "UTC", "Mars/Olympus", ""}
+
+	for _, v := range examples {
+		fmt.Println(is.Timezone(v))
+	}
+	// Output:
+	// true
+	// true
+	// false
+	// false
+}
+
 func ExampleUint() {
 	s := []interface{}{
 		byte('a'),
@@ -351,3 +557,11 @@ func ExampleUUID() {
 	// true
 	// false
 }
+
+func ExampleWithin() {
+	fmt.Println(is.Within(time.Now().Add(-time.Hour), 2*time.Hour))
+	fmt.Println(is.Within(time.Now().Add(3*time.Hour), 2*time.Hour))
+	// Output:
+	// true
+	// false
+}
@@ -0,0 +1,146 @@
+package is
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy holds requirements of password checked by [Password].
+// Zero values disable requirements.
+type PasswordPolicy struct {
+	MinLength  int      // Minimum number of characters.
+	Upper      bool     // Requires an uppercase letter.
+	Lower      bool     // Requires a lowercase letter.
+	Digit      bool     // Requires a digit.
+	Symbol     bool     // Requires a punctuation or symbol character.
+	MaxRepeat  int      // Maximum number of consecutive repeated characters.
+	Forbidden  []string // Forbidden sequences, matched case-insensitively.
+	MinEntropy float64  // Minimum entropy in bits, see [PasswordEntropy].
+}
+
+// DefaultPasswordPolicy is policy of [StrongPassword].
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:  12,
+	Upper:      true,
+	Lower:      true,
+	Digit:      true,
+	Symbol:     true,
+	MaxRepeat:  3,
+	Forbidden:  []string{"password", "qwerty", "letmein", "123456", "abcdef"},
+	MinEntropy: 60,
+}
+
+// Password returns requirements of policy 'p' unmet by password 'v', in
+// order of [PasswordPolicy] fields: "length", "upper", "lower", "digit",
+// "symbol", "repeat", "sequence" and "entropy". Uppercase and lowercase
+// letters are detected like [Lowercase] and [Uppercase] check the value.
+func Password(v string, p PasswordPolicy) []string {
+	var unmet []string
+	if utf8.RuneCountInString(v) < p.MinLength {
+		unmet = append(unmet, "length")
+	}
+
+	// A value has an uppercase letter if it is not lower case, and the
+	// other way around.
+	if p.Upper && Lowercase(v) {
+		unmet = append(unmet, "upper")
+	}
+
+	if p.Lower && Uppercase(v) {
+		unmet = append(unmet, "lower")
+	}
+
+	if p.Digit && strings.IndexFunc(v, unicode.IsDigit) < 0 {
+		unmet = append(unmet, "digit")
+	}
+
+	if p.Symbol && strings.IndexFunc(v, symbol) < 0 {
+		unmet = append(unmet, "symbol")
+	}
+
+	if p.MaxRepeat > 0 && repeated(v) > p.MaxRepeat {
+		unmet = append(unmet, "repeat")
+	}
+
+	var lv = strings.ToLower(v)
+	for _, s := range p.Forbidden {
+		if s != "" && strings.Contains(lv, strings.ToLower(s)) {
+			unmet = append(unmet, "sequence")
+			break
+		}
+	}
+
+	if p.MinEntropy > 0 && PasswordEntropy(v) < p.MinEntropy {
+		unmet = append(unmet, "entropy")
+	}
+	return unmet
+}
+
+// PasswordEntropy estimates entropy of password 'v' in bits, as number of
+// characters times binary logarithm of size of character classes used:
+// 26 lowercase letters, 26 uppercase letters, 10 digits, 33 symbols and
+// 100 other characters.
+func PasswordEntropy(v string) float64 {
+	var lower, upper, digit, sym, other bool
+	for _, r := range v {
+		switch {
+		case r < utf8.RuneSelf && unicode.IsLower(r):
+			lower = true
+		case r < utf8.RuneSelf && unicode.IsUpper(r):
+			upper = true
+		case r < utf8.RuneSelf && unicode.IsDigit(r):
+			digit = true
+		case r < utf8.RuneSelf && symbol(r):
+			sym = true
+		default:
+			other = true
+		}
+	}
+
+	var pool float64
+	for _, c := range []struct {
+		used bool
+		size float64
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {sym, 33}, {other, 100}} {
+		if c.used {
+			pool += c.size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(v)) * math.Log2(pool)
+}
+
+// StrongPassword checks if 'v' meets [DefaultPasswordPolicy].
+func StrongPassword(v string) bool {
+	return len(Password(v, DefaultPasswordPolicy)) == 0
+}
+
+// symbol checks if 'r' is punctuation or symbol character.
+func symbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
+
+// repeated returns maximum number of consecutive repeated characters of
+// 'v'.
+func repeated(v string) int {
+	var n, max int
+	var prev rune = -1
+	for _, r := range v {
+		if r == prev {
+			n++
+		} else {
+			n = 1
+		}
+
+		if n > max {
+			max = n
+		}
+		prev = r
+	}
+	return max
+}
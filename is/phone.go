@@ -0,0 +1,162 @@
+package is
+
+import (
+	"strings"
+
+	"github.com/n4x2/zoo/regex"
+)
+
+// phoneCountry holds numbering plan of a country.
+type phoneCountry struct {
+	code     string // Country calling code.
+	trunk    string // Trunk prefix dialed before national numbers.
+	min, max int    // Lengths of national significant numbers.
+}
+
+// phoneCountries maps ISO 3166-1 alpha-2 codes into numbering plans.
+var phoneCountries = map[string]phoneCountry{
+	"AE": {"971", "0", 8, 9},
+	"AR": {"54", "0", 10, 11},
+	"AT": {"43", "0", 4, 13},
+	"AU": {"61", "0", 9, 9},
+	"BD": {"880", "0", 8, 10},
+	"BE": {"32", "0", 8, 9},
+	"BR": {"55", "0", 10, 11},
+	"CA": {"1", "1", 10, 10},
+	"CH": {"41", "0", 9, 9},
+	"CL": {"56", "", 9, 9},
+	"CN": {"86", "0", 9, 11},
+	"CO": {"57", "", 10, 10},
+	"CZ": {"420", "", 9, 9},
+	"DE": {"49", "0", 6, 13},
+	"DK": {"45", "", 8, 8},
+	"EG": {"20", "0", 8, 10},
+	"ES": {"34", "", 9, 9},
+	"FI": {"358", "0", 5, 12},
+	"FR": {"33", "0", 9, 9},
+	"GB": {"44", "0", 9, 10},
+	"GR": {"30", "", 10, 10},
+	"HK": {"852", "", 8, 8},
+	"HU": {"36", "06", 8, 9},
+	"ID": {"62", "0", 8, 12},
+	"IE": {"353", "0", 7, 9},
+	"IL": {"972", "0", 8, 9},
+	"IN": {"91", "0", 10, 10},
+	"IT": {"39", "", 6, 11},
+	"JP": {"81", "0", 9, 10},
+	"KE": {"254", "0", 9, 9},
+	"KR": {"82", "0", 8, 10},
+	"KZ": {"7", "8", 10, 10},
+	"LK": {"94", "0", 9, 9},
+	"MX": {"52", "", 10, 10},
+	"MY": {"60", "0", 8, 10},
+	"NG": {"234", "0", 8, 10},
+	"NL": {"31", "0", 9, 9},
+	"NO": {"47", "", 8, 8},
+	"NZ": {"64", "0", 8, 10},
+	"PE": {"51", "0", 8, 9},
+	"PH": {"63", "0", 8, 10},
+	"PK": {"92", "0", 9, 10},
+	"PL": {"48", "", 9, 9},
+	"PT": {"351", "", 9, 9},
+	"RO": {"40", "0", 9, 9},
+	"RU": {"7", "8", 10, 10},
+	"SA": {"966", "0", 8, 9},
+	"SE": {"46", "0", 7, 10},
+	"SG": {"65", "", 8, 8},
+	"TH": {"66", "0", 8, 9},
+	"TR": {"90", "0", 10, 10},
+	"TW": {"886", "0", 8, 9},
+	"UA": {"380", "0", 9, 9},
+	"US": {"1", "1", 10, 10},
+	"VE": {"58", "0", 10, 10},
+	"VN": {"84", "0", 9, 10},
+	"ZA": {"27", "0", 9, 9},
+}
+
+// CallingCode returns country calling code of ISO 3166-1 alpha-2 country
+// code 'region', e.g. "62" for "ID". It returns false if the country is
+// not known.
+func CallingCode(region string) (string, bool) {
+	c, ok := phoneCountries[strings.ToUpper(region)]
+	return c.code, ok
+}
+
+// E164 checks if the value is a phone number in E.164 format such as
+// "+6281234567890". Numbers of a known country calling code must have
+// length of national number valid in the country, others are only checked
+// for the E.164 shape.
+func E164(v string) bool {
+	if !regex.E164.MatchString(v) {
+		return false
+	}
+
+	var known bool
+	for _, c := range phoneCountries {
+		if national(v[1:], c) {
+			return true
+		}
+		known = known || strings.HasPrefix(v[1:], c.code)
+	}
+	return !known
+}
+
+// Phone checks if the value is a phone number of country 'region' in
+// E.164 format or in national format such as "0812-3456-7890", see
+// [NormalizePhone].
+func Phone(v, region string) bool {
+	_, ok := NormalizePhone(v, region)
+	return ok
+}
+
+// NormalizePhone converts phone number 'v' of country 'region' into E.164
+// format, e.g. "0812-3456-7890" of "ID" into "+6281234567890". Spaces,
+// dashes, dots, slashes and parentheses are removed, international prefix
+// "00" is read as "+" and trunk prefix of national numbers is dropped. It
+// returns false if the number is malformed or not valid in the country.
+func NormalizePhone(v, region string) (string, bool) {
+	c, ok := phoneCountries[strings.ToUpper(region)]
+	if !ok {
+		return "", false
+	}
+
+	var d = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '/', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(v))
+
+	var intl bool
+	switch {
+	case strings.HasPrefix(d, "+"):
+		d, intl = d[1:], true
+	case strings.HasPrefix(d, "00"):
+		d, intl = d[2:], true
+	}
+
+	if d == "" || strings.Trim(d, "0123456789") != "" {
+		return "", false
+	}
+
+	if !intl {
+		d = c.code + strings.TrimPrefix(d, c.trunk)
+	}
+
+	if !national(d, c) {
+		return "", false
+	}
+	return "+" + d, true
+}
+
+// national checks if digits 'd' are country calling code of 'c' followed
+// by national number of valid length.
+func national(d string, c phoneCountry) bool {
+	if !strings.HasPrefix(d, c.code) {
+		return false
+	}
+
+	var n = len(d) - len(c.code)
+	return n >= c.min && n <= c.max
+}
@@ -0,0 +1,169 @@
+package to
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// SliceSep is separator used by [Slice] to split string values.
+const SliceSep = ","
+
+// ErrDuplicateKey indicates that distinct keys of a map convert into the
+// same key, e.g. 1 and "1" into string, it is wrapped by [KeyError].
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// IndexError an error type returned when conversion of slice or array
+// element fails.
+type IndexError struct {
+	Index int   // Index of the element.
+	Err   error // The cause.
+}
+
+// Error an error for the IndexError type.
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the cause of the IndexError.
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// KeyError an error type returned when conversion of map key or value
+// fails.
+type KeyError struct {
+	Key any   // The map key.
+	Err error // The cause.
+}
+
+// Error an error for the KeyError type.
+func (e *KeyError) Error() string {
+	return fmt.Sprintf("key %q: %v", fmt.Sprint(e.Key), e.Err)
+}
+
+// Unwrap returns the cause of the KeyError.
+func (e *KeyError) Unwrap() error {
+	return e.Err
+}
+
+// Slice convert given 'v' to slice of T. It accepts slices and arrays of
+// any type, converting each element with [Convert], and strings that are
+// split by [SliceSep] with surrounding spaces trimmed. Other values are
+// converted into a single element slice. It returns [IndexError] if an
+// element fails to convert.
+func Slice[T Scalar](v any) ([]T, error) {
+	var rv = reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return nil, nil
+		}
+	}
+
+	rv = elements(v)
+	var s = make([]T, rv.Len())
+	for i := range s {
+		pv, err := Convert[T](rv.Index(i).Interface())
+		if err != nil {
+			return nil, &IndexError{Index: i, Err: err}
+		}
+		s[i] = pv
+	}
+	return s, nil
+}
+
+// StringMap convert given 'v' to map[string]any type. It accepts maps of
+// any key type, converting keys with [String], and JSON object strings.
+// It returns [KeyError] if a key fails to convert or converts into key of
+// another key.
+func StringMap(v any) (map[string]any, error) {
+	switch pv := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return pv, nil
+	case string:
+		var m map[string]any
+		if err := json.Unmarshal([]byte(pv), &m); err != nil {
+			return nil, &typeConversionError{v: v, t: "map[string]any"}
+		}
+		return m, nil
+	}
+
+	var rv = reflect.ValueOf(v)
+	if rv.Kind() != reflect.Map {
+		return nil, &typeConversionError{v: v, t: "map[string]any"}
+	}
+
+	var m = make(map[string]any, rv.Len())
+	for _, k := range keys(rv) {
+		pk, err := String(k.Interface())
+		if err != nil {
+			return nil, &KeyError{Key: k.Interface(), Err: err}
+		}
+
+		if _, ok := m[pk]; ok {
+			return nil, &KeyError{Key: k.Interface(), Err: ErrDuplicateKey}
+		}
+		m[pk] = rv.MapIndex(k).Interface()
+	}
+	return m, nil
+}
+
+// Map convert given 'v' to map of K and V. It accepts maps of any type,
+// converting each key and value with [Convert], and JSON object strings.
+// It returns [KeyError] for the first failing key in sorted order,
+// including keys converting into key of another key.
+func Map[K, V Scalar](v any) (map[K]V, error) {
+	if s, ok := v.(string); ok {
+		pv, err := StringMap(s)
+		if err != nil {
+			return nil, err
+		}
+		v = pv
+	}
+
+	var rv = reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Map:
+	default:
+		return nil, &typeConversionError{v: v, t: fmt.Sprintf("%T", map[K]V{})}
+	}
+
+	var m = make(map[K]V, rv.Len())
+	for _, k := range keys(rv) {
+		pk, err := Convert[K](k.Interface())
+		if err != nil {
+			return nil, &KeyError{Key: k.Interface(), Err: err}
+		}
+
+		if _, ok := m[pk]; ok {
+			return nil, &KeyError{Key: k.Interface(), Err: ErrDuplicateKey}
+		}
+
+		pv, err := Convert[V](rv.MapIndex(k).Interface())
+		if err != nil {
+			return nil, &KeyError{Key: k.Interface(), Err: err}
+		}
+		m[pk] = pv
+	}
+	return m, nil
+}
+
+// keys returns keys of map 'rv' sorted by their string representation,
+// so errors are reported in predictable order.
+func keys(rv reflect.Value) []reflect.Value {
+	var k = rv.MapKeys()
+	sort.Slice(k, func(i, j int) bool {
+		return fmt.Sprint(k[i].Interface()) < fmt.Sprint(k[j].Interface())
+	})
+	return k
+}
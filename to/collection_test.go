@@ -0,0 +1,91 @@
+package to
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	t.Parallel()
+
+	s, err := Slice[int]([]any{1, "2", 3.0, opaque{}})
+	var ie *IndexError
+	if !errors.As(err, &ie) || ie.Index != 3 || s != nil {
+		t.Errorf("expected index error at 3, got %v", err)
+	}
+
+	var tests = []struct {
+		name  string
+		input any
+		x     []int
+	}{
+		{"any slice", []any{1, "2", 3.0}, []int{1, 2, 3}},
+		{"string slice", []string{"4", "5"}, []int{4, 5}},
+		{"array", [2]uint8{6, 7}, []int{6, 7}},
+		{"comma separated", "8, 9,10", []int{8, 9, 10}},
+		{"empty string", "", []int{}},
+		{"scalar", int64(11), []int{11}},
+		{"nil", nil, nil},
+	}
+	for _, test := range tests {
+		value, err := Slice[int](test.input)
+		if err != nil || !reflect.DeepEqual(value, test.x) {
+			t.Errorf("%s: expected %v, got %v, %v", test.name, test.x, value, err)
+		}
+	}
+}
+
+type opaque struct{}
+
+func TestStringMap(t *testing.T) {
+	t.Parallel()
+
+	m, err := StringMap(map[any]any{1: "a", true: 2})
+	if err != nil || !reflect.DeepEqual(m, map[string]any{"1": "a", "true": 2}) {
+		t.Errorf("unexpected result %v, %v", m, err)
+	}
+
+	m, err = StringMap(`{"a": 1}`)
+	if err != nil || !reflect.DeepEqual(m, map[string]any{"a": float64(1)}) {
+		t.Errorf("unexpected result %v, %v", m, err)
+	}
+
+	var ke *KeyError
+	if _, err = StringMap(map[opaque]int{{}: 1}); !errors.As(err, &ke) {
+		t.Errorf("expected key error, got %v", err)
+	}
+
+	if _, err = StringMap([]int{1}); err == nil {
+		t.Errorf("expected error for slice")
+	}
+
+	if _, err = StringMap(map[any]int{1: 1, "1": 2}); !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("expected duplicate key error, got %v", err)
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	m, err := Map[string, int](map[string]any{"a": "1", "b": 2.0})
+	if err != nil || !reflect.DeepEqual(m, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("unexpected result %v, %v", m, err)
+	}
+
+	n, err := Map[int, bool](`{"1": true, "2": "false"}`)
+	if err != nil || !reflect.DeepEqual(n, map[int]bool{1: true, 2: false}) {
+		t.Errorf("unexpected result %v, %v", n, err)
+	}
+
+	_, err = Map[string, int](map[string]any{"a": 1, "b": "x", "c": "y"})
+	var ke *KeyError
+	if !errors.As(err, &ke) || ke.Key != "b" {
+		t.Errorf("expected key error at b, got %v", err)
+	}
+
+	_, err = Map[int, int](map[string]int{"1": 1, "01": 2})
+	if !errors.Is(err, ErrDuplicateKey) {
+		t.Errorf("expected duplicate key error, got %v", err)
+	}
+}
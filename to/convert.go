@@ -0,0 +1,313 @@
+package to
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/n4x2/zoo/constraints"
+)
+
+// Scalar is a constraint that permits any type supported by [Convert].
+type Scalar interface {
+	constraints.Number | ~string | ~bool
+}
+
+var (
+	stringerType        = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// converters stores user-defined converters registered with [Register],
+// keyed by source and target type.
+var converters = struct {
+	sync.RWMutex
+	m map[[2]reflect.Type]func(any) (any, error)
+}{m: make(map[[2]reflect.Type]func(any) (any, error))}
+
+// Register add 'fn' as converter from type S to type T. Registered
+// converters take precedence over built-in conversions, registering the
+// same pair twice replaces the previous converter.
+func Register[S, T any](fn func(S) (T, error)) {
+	var k = [2]reflect.Type{
+		reflect.TypeOf((*S)(nil)).Elem(),
+		reflect.TypeOf((*T)(nil)).Elem(),
+	}
+
+	converters.Lock()
+	defer converters.Unlock()
+
+	converters.m[k] = func(v any) (any, error) {
+		return fn(v.(S))
+	}
+}
+
+// lookup returns registered converter from 's' to 't'.
+func lookup(s, t reflect.Type) (func(any) (any, error), bool) {
+	converters.RLock()
+	defer converters.RUnlock()
+
+	fn, ok := converters.m[[2]reflect.Type{s, t}]
+	return fn, ok
+}
+
+// Convert convert given 'v' to type T. In addition to built-in types, it
+// accepts pointers, named types such as `type Age int` or json.Number,
+// values implementing [fmt.Stringer] or [encoding.TextMarshaler], and
+// targets implementing [encoding.TextUnmarshaler]. Converters added with
+// [Register] are used first.
+func Convert[T Scalar](v any) (T, error) {
+	var x T
+
+	rv, err := convert(v, reflect.TypeOf(x))
+	if err != nil {
+		return x, err
+	}
+	return rv.Interface().(T), nil
+}
+
+// convert convert given 'v' to value of type 't'.
+func convert(v any, t reflect.Type) (reflect.Value, error) {
+	if v == nil {
+		return reflect.Zero(t), nil
+	}
+
+	var vt = reflect.TypeOf(v)
+	if fn, ok := lookup(vt, t); ok {
+		pv, err := fn(v)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(pv), nil
+	}
+
+	if vt == t {
+		return reflect.ValueOf(v), nil
+	}
+
+	var rv = reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return reflect.Zero(t), nil
+		}
+
+		if !vt.Implements(textMarshalerType) && !vt.Implements(stringerType) {
+			return convert(rv.Elem().Interface(), t)
+		}
+	}
+
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		if rv.Kind() == reflect.String {
+			var pv = reflect.New(t)
+			err := pv.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(rv.String()))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return pv.Elem(), nil
+		}
+	}
+
+	bv, ok := base(v, t.Kind() == reflect.String)
+	if !ok {
+		return reflect.Value{}, &typeConversionError{v: v, t: t.String()}
+	}
+
+	var pv = reflect.New(t).Elem()
+	if err := set(pv, bv); err != nil {
+		if _, ok := err.(*typeConversionError); ok {
+			return reflect.Value{}, &typeConversionError{v: v, t: t.String()}
+		}
+		return reflect.Value{}, err
+	}
+	return pv, nil
+}
+
+// base returns value of 'v' as one of built-in types: bool, float32,
+// float64, int64, uint64 or string. Named types are converted to their
+// underlying type, [encoding.TextMarshaler] and [fmt.Stringer] are
+// converted to string, preferred over the underlying type if 'text' is
+// true. It returns false if 'v' cannot be represented by those types.
+func base(v any, text bool) (any, bool) {
+	switch v := v.(type) {
+	case bool, float32, float64, int64, uint64, string:
+		return v, true
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case uint:
+		return uint64(v), true
+	case uint8:
+		return uint64(v), true
+	case uint16:
+		return uint64(v), true
+	case uint32:
+		return uint64(v), true
+	}
+
+	var rv = reflect.ValueOf(v)
+	if text || !isBasic(rv.Kind()) {
+		switch {
+		case rv.Type().Implements(textMarshalerType):
+			b, err := v.(encoding.TextMarshaler).MarshalText()
+			return string(b), err == nil
+		case rv.Type().Implements(stringerType):
+			return v.(fmt.Stringer).String(), true
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return rv.Bool(), true
+	case reflect.Float32:
+		return float32(rv.Float()), true
+	case reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), true
+	case reflect.String:
+		return rv.String(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), true
+	default:
+		return nil, false
+	}
+}
+
+// isBasic checks if 'k' is kind of bool, number or string.
+func isBasic(k reflect.Kind) bool {
+	return k >= reflect.Bool && k <= reflect.Float64 && k != reflect.Uintptr || k == reflect.String
+}
+
+// set store 'v' returned by [base] into 'pv' according to its kind. It
+// returns an error if the value is not accepted by the kind.
+func set(pv reflect.Value, v any) error {
+	var (
+		k    = pv.Kind()
+		bits = pv.Type().Bits
+	)
+
+	switch k {
+	case reflect.Bool:
+		switch v := v.(type) {
+		case bool:
+			pv.SetBool(v)
+		case float32:
+			pv.SetBool(v != 0)
+		case float64:
+			pv.SetBool(v != 0)
+		case int64:
+			pv.SetBool(v != 0)
+		case uint64:
+			pv.SetBool(v != 0)
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			pv.SetBool(b)
+		}
+	case reflect.Float32, reflect.Float64:
+		switch v := v.(type) {
+		case bool:
+			pv.SetFloat(btof(v))
+		case float32:
+			pv.SetFloat(float64(v))
+		case float64:
+			pv.SetFloat(v)
+		case int64:
+			pv.SetFloat(float64(v))
+		case uint64:
+			pv.SetFloat(float64(v))
+		case string:
+			f, err := strconv.ParseFloat(v, bits())
+			if err != nil {
+				return &typeConversionError{}
+			}
+			pv.SetFloat(f)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch v := v.(type) {
+		case bool:
+			pv.SetInt(int64(btof(v)))
+		case float32:
+			pv.SetInt(int64(v))
+		case float64:
+			pv.SetInt(int64(v))
+		case int64:
+			pv.SetInt(v)
+		case uint64:
+			pv.SetInt(int64(v))
+		case string:
+			i, err := strconv.ParseInt(v, 0, bits())
+			if err != nil {
+				return &typeConversionError{}
+			}
+			pv.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch v := v.(type) {
+		case bool:
+			pv.SetUint(uint64(btof(v)))
+		case float32:
+			if v < 0 {
+				return negativeValueError
+			}
+			pv.SetUint(uint64(v))
+		case float64:
+			if v < 0 {
+				return negativeValueError
+			}
+			pv.SetUint(uint64(v))
+		case int64:
+			if v < 0 {
+				return negativeValueError
+			}
+			pv.SetUint(uint64(v))
+		case uint64:
+			pv.SetUint(v)
+		case string:
+			i, err := strconv.ParseUint(v, 0, bits())
+			if err != nil {
+				if i, err := strconv.ParseInt(v, 0, 64); err == nil && i < 0 {
+					return negativeValueError
+				}
+				return &typeConversionError{}
+			}
+			pv.SetUint(i)
+		}
+	case reflect.String:
+		switch v := v.(type) {
+		case bool:
+			pv.SetString(strconv.FormatBool(v))
+		case float32:
+			pv.SetString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+		case float64:
+			pv.SetString(strconv.FormatFloat(v, 'f', -1, 64))
+		case int64:
+			pv.SetString(strconv.FormatInt(v, 10))
+		case uint64:
+			pv.SetString(strconv.FormatUint(v, 10))
+		case string:
+			pv.SetString(v)
+		}
+	default:
+		return &typeConversionError{}
+	}
+	return nil
+}
+
+// btof convert boolean to 1 or 0.
+func btof(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
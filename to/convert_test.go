@@ -0,0 +1,97 @@
+package to
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type (
+	age    int
+	name   string
+	flag   bool
+	weight float32
+)
+
+type level int
+
+func (l *level) UnmarshalText(b []byte) error {
+	switch strings.ToLower(string(b)) {
+	case "low":
+		*l = 1
+	case "high":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+type color int
+
+func (c color) String() string {
+	return [...]string{"red", "green", "blue"}[c]
+}
+
+type point struct{ x, y int }
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	var n = 7
+
+	assertConvert(t, age(30), 30, nil)
+	assertConvert(t, "30", age(30), nil)
+	assertConvert(t, json.Number("12"), int64(12), nil)
+	assertConvert(t, json.Number("1.5"), 1.5, nil)
+	assertConvert(t, &n, uint8(7), nil)
+	assertConvert(t, (*int)(nil), 0, nil)
+	assertConvert(t, name("x"), "x", nil)
+	assertConvert(t, 1, flag(true), nil)
+	assertConvert(t, "1.25", weight(1.25), nil)
+	assertConvert(t, color(2), "blue", nil)
+	assertConvert(t, color(2), 2, nil)
+	assertConvert(t, time.Second, "1s", nil)
+	assertConvert(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), "2024-01-02T00:00:00Z", nil)
+	assertConvert(t, "HIGH", level(2), nil)
+	assertConvert(t, "-1", uint(0), negativeValueError)
+	assertConvert(t, "255", uint8(255), nil)
+
+	if _, err := Convert[level]("medium"); err == nil {
+		t.Errorf("expected error from UnmarshalText")
+	}
+
+	if _, err := Convert[age](point{}); err == nil || err.Error() != "unable to convert {0 0} type of to.point to to.age" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register(func(p point) (int, error) {
+		return p.x + p.y, nil
+	})
+
+	assertConvert(t, point{1, 2}, 3, nil)
+	assertConvert(t, &point{2, 3}, 5, nil)
+}
+
+func assertConvert[T Scalar](t *testing.T, v any, x T, e error) {
+	t.Helper()
+	value, err := Convert[T](v)
+	if e != nil {
+		if !errors.Is(err, e) {
+			t.Errorf("%v: expected error %v, got %v", v, e, err)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Errorf("%v: unexpected error %v", v, err)
+		return
+	}
+
+	if value != x {
+		t.Errorf("%v: expected %v, got %v", v, x, value)
+	}
+}
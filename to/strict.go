@@ -0,0 +1,382 @@
+package to
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Errors wrapped by [NumError] when strict conversion is not possible
+// without changing the value.
+var (
+	// ErrOverflow indicates that the value is out of range of the target
+	// type, including negative values for unsigned integers and infinite
+	// floating-point values.
+	ErrOverflow = errors.New("value out of range")
+	// ErrPrecisionLoss indicates that the value cannot be represented
+	// exactly by the target type, e.g. fractional or NaN floating-point
+	// values converted to integers.
+	ErrPrecisionLoss = errors.New("precision loss")
+)
+
+// NumError an error type returned by strict conversions when the value
+// would be changed by the conversion. Use [errors.Is] with [ErrOverflow]
+// or [ErrPrecisionLoss] to check the cause.
+type NumError struct {
+	V   any    // The value.
+	T   string // Target type.
+	Err error  // The cause.
+}
+
+// Error an error for the NumError type.
+func (e *NumError) Error() string {
+	return fmt.Sprintf("unable to convert %v type of %T to %s: %v", e.V, e.V, e.T, e.Err)
+}
+
+// Unwrap returns the cause of the NumError.
+func (e *NumError) Unwrap() error {
+	return e.Err
+}
+
+// strictInt convert given 'v' to signed integer of 'bits' size. It
+// returns [NumError] if the value overflows or loses precision.
+func strictInt(v any, bits int, t string) (int64, error) {
+	var (
+		lo = int64(-1) << (bits - 1)
+		hi = int64(1)<<(bits-1) - 1
+		x  int64
+	)
+
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case float32:
+		return floatToInt(v, float64(v), bits, t)
+	case float64:
+		return floatToInt(v, v, bits, t)
+	case int:
+		x = int64(v)
+	case int8:
+		x = int64(v)
+	case int16:
+		x = int64(v)
+	case int32:
+		x = int64(v)
+	case int64:
+		x = v
+	case nil:
+		return 0, nil
+	case string:
+		pv, err := strconv.ParseInt(v, 0, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+			}
+			return 0, &typeConversionError{v: v, t: t}
+		}
+		x = pv
+	case uint:
+		return uintToInt(v, uint64(v), hi, t)
+	case uint8:
+		return uintToInt(v, uint64(v), hi, t)
+	case uint16:
+		return uintToInt(v, uint64(v), hi, t)
+	case uint32:
+		return uintToInt(v, uint64(v), hi, t)
+	case uint64:
+		return uintToInt(v, v, hi, t)
+	default:
+		return 0, &typeConversionError{v: v, t: t}
+	}
+
+	if x < lo || x > hi {
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	}
+	return x, nil
+}
+
+// strictUint convert given 'v' to unsigned integer of 'bits' size. It
+// returns [NumError] if the value overflows or loses precision.
+func strictUint(v any, bits int, t string) (uint64, error) {
+	var (
+		hi = uint64(math.MaxUint64) >> (64 - bits)
+		x  uint64
+	)
+
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case float32:
+		return floatToUint(v, float64(v), bits, t)
+	case float64:
+		return floatToUint(v, v, bits, t)
+	case int:
+		return intToUint(v, int64(v), hi, t)
+	case int8:
+		return intToUint(v, int64(v), hi, t)
+	case int16:
+		return intToUint(v, int64(v), hi, t)
+	case int32:
+		return intToUint(v, int64(v), hi, t)
+	case int64:
+		return intToUint(v, v, hi, t)
+	case nil:
+		return 0, nil
+	case string:
+		pv, err := strconv.ParseUint(v, 0, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+			}
+
+			if _, err := strconv.ParseInt(v, 0, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+				return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+			}
+			return 0, &typeConversionError{v: v, t: t}
+		}
+		x = pv
+	case uint:
+		x = uint64(v)
+	case uint8:
+		x = uint64(v)
+	case uint16:
+		x = uint64(v)
+	case uint32:
+		x = uint64(v)
+	case uint64:
+		x = v
+	default:
+		return 0, &typeConversionError{v: v, t: t}
+	}
+
+	if x > hi {
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	}
+	return x, nil
+}
+
+// strictFloat convert given 'v' to floating-point of 'bits' size. It
+// returns [NumError] if the value overflows or loses precision.
+func strictFloat(v any, bits int, t string) (float64, error) {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		if bits == 64 || math.IsNaN(v) {
+			return v, nil
+		}
+
+		if math.Abs(v) > math.MaxFloat32 && !math.IsInf(v, 0) {
+			return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+		}
+
+		if float64(float32(v)) != v {
+			return 0, &NumError{V: v, T: t, Err: ErrPrecisionLoss}
+		}
+		return v, nil
+	case int:
+		return intToFloat(v, int64(v), bits, t)
+	case int8:
+		return float64(v), nil
+	case int16:
+		return float64(v), nil
+	case int32:
+		return intToFloat(v, int64(v), bits, t)
+	case int64:
+		return intToFloat(v, v, bits, t)
+	case nil:
+		return 0, nil
+	case string:
+		pv, err := strconv.ParseFloat(v, bits)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+			}
+			return 0, &typeConversionError{v: v, t: t}
+		}
+		return pv, nil
+	case uint:
+		return uintToFloat(v, uint64(v), bits, t)
+	case uint8:
+		return float64(v), nil
+	case uint16:
+		return float64(v), nil
+	case uint32:
+		return uintToFloat(v, uint64(v), bits, t)
+	case uint64:
+		return uintToFloat(v, v, bits, t)
+	default:
+		return 0, &typeConversionError{v: v, t: t}
+	}
+}
+
+// floatToInt convert 'f' to signed integer of 'bits' size, 'v' is the
+// original value used for error reporting.
+func floatToInt(v any, f float64, bits int, t string) (int64, error) {
+	switch {
+	case math.IsNaN(f), f != math.Trunc(f) && !math.IsInf(f, 0):
+		return 0, &NumError{V: v, T: t, Err: ErrPrecisionLoss}
+	case f < -math.Ldexp(1, bits-1), f >= math.Ldexp(1, bits-1):
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	default:
+		return int64(f), nil
+	}
+}
+
+// floatToUint convert 'f' to unsigned integer of 'bits' size, 'v' is the
+// original value used for error reporting.
+func floatToUint(v any, f float64, bits int, t string) (uint64, error) {
+	switch {
+	case math.IsNaN(f), f != math.Trunc(f) && !math.IsInf(f, 0):
+		return 0, &NumError{V: v, T: t, Err: ErrPrecisionLoss}
+	case f < 0, f >= math.Ldexp(1, bits):
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	default:
+		return uint64(f), nil
+	}
+}
+
+// intToUint convert signed integer 'x' to unsigned integer not greater
+// than 'hi', 'v' is the original value used for error reporting.
+func intToUint(v any, x int64, hi uint64, t string) (uint64, error) {
+	if x < 0 || uint64(x) > hi {
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	}
+	return uint64(x), nil
+}
+
+// uintToInt convert unsigned integer 'x' to signed integer not greater
+// than 'hi', 'v' is the original value used for error reporting.
+func uintToInt(v any, x uint64, hi int64, t string) (int64, error) {
+	if x > uint64(hi) {
+		return 0, &NumError{V: v, T: t, Err: ErrOverflow}
+	}
+	return int64(x), nil
+}
+
+// intToFloat convert signed integer 'x' to floating-point of 'bits' size,
+// 'v' is the original value used for error reporting.
+func intToFloat(v any, x int64, bits int, t string) (float64, error) {
+	var f = float64(x)
+	if bits == 32 {
+		f = float64(float32(x))
+	}
+
+	if f >= math.Ldexp(1, 63) || int64(f) != x {
+		return 0, &NumError{V: v, T: t, Err: ErrPrecisionLoss}
+	}
+	return f, nil
+}
+
+// uintToFloat convert unsigned integer 'x' to floating-point of 'bits'
+// size, 'v' is the original value used for error reporting.
+func uintToFloat(v any, x uint64, bits int, t string) (float64, error) {
+	var f = float64(x)
+	if bits == 32 {
+		f = float64(float32(x))
+	}
+
+	if f >= math.Ldexp(1, 64) || uint64(f) != x {
+		return 0, &NumError{V: v, T: t, Err: ErrPrecisionLoss}
+	}
+	return f, nil
+}
+
+// StrictFloat32 convert given 'v' to float32 type. Unlike [Float32], it
+// returns [NumError] if the value is out of range or cannot be represented
+// exactly.
+func StrictFloat32(v interface{}) (float32, error) {
+	pv, err := strictFloat(v, 32, f32)
+	return float32(pv), err
+}
+
+// StrictFloat64 convert given 'v' to float64 type. Unlike [Float64], it
+// returns [NumError] if the value cannot be represented exactly, e.g.
+// integers greater than 2^53.
+func StrictFloat64(v interface{}) (float64, error) {
+	return strictFloat(v, 64, f64)
+}
+
+// StrictInt convert given 'v' to int type. Unlike [Int], it returns
+// [NumError] if the value is out of range or has fractional part.
+func StrictInt(v interface{}) (int, error) {
+	pv, err := strictInt(v, strconv.IntSize, i)
+	return int(pv), err
+}
+
+// StrictInt8 convert given 'v' to int8 type. Unlike [Int8], it returns
+// [NumError] if the value is out of range or has fractional part.
+func StrictInt8(v interface{}) (int8, error) {
+	pv, err := strictInt(v, 8, i8)
+	return int8(pv), err
+}
+
+// StrictInt16 convert given 'v' to int16 type. Unlike [Int16], it returns
+// [NumError] if the value is out of range or has fractional part.
+func StrictInt16(v interface{}) (int16, error) {
+	pv, err := strictInt(v, 16, i16)
+	return int16(pv), err
+}
+
+// StrictInt32 convert given 'v' to int32 type. Unlike [Int32], it returns
+// [NumError] if the value is out of range or has fractional part.
+func StrictInt32(v interface{}) (int32, error) {
+	pv, err := strictInt(v, 32, i32)
+	return int32(pv), err
+}
+
+// StrictInt64 convert given 'v' to int64 type. Unlike [Int64], it returns
+// [NumError] if the value is out of range or has fractional part.
+func StrictInt64(v interface{}) (int64, error) {
+	return strictInt(v, 64, i64)
+}
+
+// StrictUint convert given 'v' to uint type. Unlike [Uint], it returns
+// [NumError] if the value is negative, out of range or has fractional
+// part.
+func StrictUint(v interface{}) (uint, error) {
+	pv, err := strictUint(v, strconv.IntSize, u)
+	return uint(pv), err
+}
+
+// StrictUint8 convert given 'v' to uint8 type. Unlike [Uint8], it returns
+// [NumError] if the value is negative, out of range or has fractional
+// part.
+func StrictUint8(v interface{}) (uint8, error) {
+	pv, err := strictUint(v, 8, u8)
+	return uint8(pv), err
+}
+
+// StrictUint16 convert given 'v' to uint16 type. Unlike [Uint16], it
+// returns [NumError] if the value is negative, out of range or has
+// fractional part.
+func StrictUint16(v interface{}) (uint16, error) {
+	pv, err := strictUint(v, 16, u16)
+	return uint16(pv), err
+}
+
+// StrictUint32 convert given 'v' to uint32 type. Unlike [Uint32], it
+// returns [NumError] if the value is negative, out of range or has
+// fractional part.
+func StrictUint32(v interface{}) (uint32, error) {
+	pv, err := strictUint(v, 32, u32)
+	return uint32(pv), err
+}
+
+// StrictUint64 convert given 'v' to uint64 type. Unlike [Uint64], it
+// returns [NumError] if the value is negative or has fractional part.
+func StrictUint64(v interface{}) (uint64, error) {
+	return strictUint(v, 64, u64)
+}
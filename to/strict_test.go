@@ -0,0 +1,90 @@
+package to
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func assertStrict[T any](t *testing.T, fn func(v any) (T, error), v any, x T, e error) {
+	t.Helper()
+	value, err := fn(v)
+	if e == nil && err != nil {
+		t.Errorf("%v: unexpected error %v", v, err)
+		return
+	}
+
+	if e != nil && !errors.Is(err, e) {
+		t.Errorf("%v: expected error %v, got %v", v, e, err)
+		return
+	}
+
+	if e == nil && any(value) != any(x) {
+		t.Errorf("%v: expected %v, got %v", v, x, value)
+	}
+}
+
+func TestStrictSignedInteger(t *testing.T) {
+	t.Parallel()
+	assertStrict(t, StrictInt8, 127, int8(127), nil)
+	assertStrict(t, StrictInt8, -128, int8(-128), nil)
+	assertStrict(t, StrictInt8, 300, 0, ErrOverflow)
+	assertStrict(t, StrictInt8, -129, 0, ErrOverflow)
+	assertStrict(t, StrictInt8, uint8(200), 0, ErrOverflow)
+	assertStrict(t, StrictInt8, "300", 0, ErrOverflow)
+	assertStrict(t, StrictInt8, "-12", int8(-12), nil)
+	assertStrict(t, StrictInt16, float64(1.9), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictInt16, float32(-3), int16(-3), nil)
+	assertStrict(t, StrictInt32, math.NaN(), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictInt32, math.Inf(-1), 0, ErrOverflow)
+	assertStrict(t, StrictInt64, uint64(math.MaxUint64), 0, ErrOverflow)
+	assertStrict(t, StrictInt64, uint64(math.MaxInt64), int64(math.MaxInt64), nil)
+	assertStrict(t, StrictInt64, float64(math.MaxInt64), 0, ErrOverflow)
+	assertStrict(t, StrictInt64, "99999999999999999999", 0, ErrOverflow)
+	assertStrict(t, StrictInt, uint(math.MaxUint), 0, ErrOverflow)
+	assertStrict(t, StrictInt, true, 1, nil)
+	assertStrict(t, StrictInt, nil, 0, nil)
+
+	if _, err := StrictInt("1.5"); err == nil {
+		t.Errorf("expected error for non integer string")
+	}
+}
+
+func TestStrictUnsignedInteger(t *testing.T) {
+	t.Parallel()
+	assertStrict(t, StrictUint8, 255, uint8(255), nil)
+	assertStrict(t, StrictUint8, int(1000), 0, ErrOverflow)
+	assertStrict(t, StrictUint8, -1, 0, ErrOverflow)
+	assertStrict(t, StrictUint8, "200", uint8(200), nil)
+	assertStrict(t, StrictUint8, "-1", 0, ErrOverflow)
+	assertStrict(t, StrictUint16, float64(2.5), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictUint16, float64(-1), 0, ErrOverflow)
+	assertStrict(t, StrictUint32, uint64(math.MaxUint32+1), 0, ErrOverflow)
+	assertStrict(t, StrictUint64, "18446744073709551615", uint64(math.MaxUint64), nil)
+	assertStrict(t, StrictUint64, "18446744073709551616", 0, ErrOverflow)
+	assertStrict(t, StrictUint64, math.Inf(1), 0, ErrOverflow)
+	assertStrict(t, StrictUint, int64(-5), 0, ErrOverflow)
+}
+
+func TestStrictFloatingPoint(t *testing.T) {
+	t.Parallel()
+	assertStrict(t, StrictFloat64, int64(1<<53), float64(1<<53), nil)
+	assertStrict(t, StrictFloat64, int64(1<<53+1), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictFloat64, uint64(math.MaxUint64), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictFloat64, "1e400", 0, ErrOverflow)
+	assertStrict(t, StrictFloat32, float64(0.5), float32(0.5), nil)
+	assertStrict(t, StrictFloat32, float64(0.1), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictFloat32, float64(math.MaxFloat64), 0, ErrOverflow)
+	assertStrict(t, StrictFloat32, int32(1<<24+1), 0, ErrPrecisionLoss)
+	assertStrict(t, StrictFloat32, "0.1", float32(0.1), nil)
+}
+
+func TestNumError_Error(t *testing.T) {
+	err := &NumError{V: 300, T: "int8", Err: ErrOverflow}
+
+	expectedErrorMsg := "unable to convert 300 type of int to int8: value out of range"
+
+	if err.Error() != expectedErrorMsg {
+		t.Errorf("Expected error message: %s, but got: %s", expectedErrorMsg, err.Error())
+	}
+}
@@ -0,0 +1,352 @@
+package to
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// StructTag is default tag used by [Struct] to map keys into struct
+// fields.
+const StructTag = "json"
+
+// errInvalidTarget returns when decoding target is not a non-nil pointer
+// to struct.
+var errInvalidTarget = errors.New("target must be a non-nil pointer to struct")
+
+// errInvalidPointer returns when assignment target is not a non-nil
+// pointer.
+var errInvalidPointer = errors.New("target must be a non-nil pointer")
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// FieldError an error type for a struct field that fails to decode.
+type FieldError struct {
+	Field string // Path of the field, e.g. "address.zip" or "tags[1]".
+	Err   error  // The cause.
+}
+
+// Error an error for the FieldError type.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the cause of the FieldError.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// StructError an error type returned by [Struct] listing every field that
+// fails to decode.
+type StructError struct {
+	Fields []*FieldError // Failing fields in struct order.
+}
+
+// Error an error for the StructError type.
+func (e *StructError) Error() string {
+	var s = make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		s[i] = f.Error()
+	}
+	return "unable to decode struct: " + strings.Join(s, "; ")
+}
+
+// Unwrap returns errors of the failing fields.
+func (e *StructError) Unwrap() []error {
+	var errs = make([]error, len(e.Fields))
+	for i, f := range e.Fields {
+		errs[i] = f
+	}
+	return errs
+}
+
+// Struct decode 'src' into struct pointed by 'dst'. Keys are matched to
+// fields by [StructTag] name, or field name if the tag is missing, case
+// insensitively. Values are converted with the same rules as [Convert],
+// [Time] and [Duration], nested structs, slices, maps and pointers are
+// decoded recursively and embedded structs are flattened. It returns
+// [StructError] listing every field that fails to decode.
+func Struct(dst any, src map[string]any) error {
+	return StructWithTag(dst, src, StructTag)
+}
+
+// StructWithTag decode 'src' into struct pointed by 'dst' like [Struct],
+// using 'tag' to map keys into struct fields.
+func StructWithTag(dst any, src map[string]any, tag string) error {
+	var rv = reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errInvalidTarget
+	}
+
+	var d = decoder{tag: tag}
+	d.decodeStruct(rv.Elem(), src, "")
+	if len(d.errs) > 0 {
+		return &StructError{Fields: d.errs}
+	}
+	return nil
+}
+
+// Assign converts 'v' and stores it into value pointed by 'dst' with the
+// same rules as fields decoded by [Struct], e.g. "a, b" into []string,
+// "1h" into time.Duration or "5" into *int allocating the pointer. It
+// returns an error if 'dst' is not a non-nil pointer or the conversion
+// fails.
+func Assign(dst, v any) error {
+	var rv = reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return errInvalidPointer
+	}
+
+	var d decoder
+	d.decode(rv.Elem(), v, "")
+	if len(d.errs) > 0 {
+		return d.errs[0].Err
+	}
+	return nil
+}
+
+// decoder holds decoding state of [StructWithTag].
+type decoder struct {
+	tag  string
+	errs []*FieldError
+}
+
+// fail records decoding error of field at 'path'.
+func (d *decoder) fail(path string, err error) {
+	var fe *FieldError
+	if errors.As(err, &fe) {
+		err = fe.Err
+	}
+	d.errs = append(d.errs, &FieldError{Field: path, Err: err})
+}
+
+// decodeStruct decode 'src' into fields of struct 'rv'.
+func (d *decoder) decodeStruct(rv reflect.Value, src map[string]any, path string) {
+	var t = rv.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		var (
+			f    = t.Field(i)
+			name = f.Name
+		)
+
+		if tv, ok := f.Tag.Lookup(d.tag); ok {
+			tn, _, _ := strings.Cut(tv, ",")
+			if tn == "-" {
+				continue
+			}
+
+			if tn != "" {
+				name = tn
+			}
+		}
+
+		var fv = rv.Field(i)
+		if f.Anonymous && name == f.Name {
+			var ft = f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+
+			if ft.Kind() == reflect.Struct {
+				if fv.Kind() == reflect.Pointer {
+					if !fv.CanSet() {
+						continue
+					}
+
+					if fv.IsNil() {
+						fv.Set(reflect.New(ft))
+					}
+					fv = fv.Elem()
+				}
+				d.decodeStruct(fv, src, path)
+				continue
+			}
+		}
+
+		if !f.IsExported() {
+			continue
+		}
+
+		v, ok := field(src, name)
+		if !ok {
+			continue
+		}
+		d.decode(fv, v, join(path, name))
+	}
+}
+
+// decode decode 'v' into 'rv' according to its type.
+func (d *decoder) decode(rv reflect.Value, v any, path string) {
+	if v == nil {
+		return
+	}
+
+	var t = rv.Type()
+	if vt := reflect.TypeOf(v); vt.AssignableTo(t) && t.Kind() != reflect.Interface {
+		rv.Set(reflect.ValueOf(v))
+		return
+	} else if _, ok := lookup(vt, t); ok {
+		d.set(rv, v, path)
+		return
+	}
+
+	switch {
+	case t == timeType:
+		pv, err := Time(v)
+		if err != nil {
+			d.fail(path, err)
+			return
+		}
+		rv.Set(reflect.ValueOf(pv))
+		return
+	case t == durationType:
+		pv, err := Duration(v)
+		if err != nil {
+			d.fail(path, err)
+			return
+		}
+		rv.SetInt(int64(pv))
+		return
+	case t.Kind() != reflect.Pointer && reflect.PointerTo(t).Implements(textUnmarshalerType):
+		if s, ok := v.(string); ok {
+			err := rv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+			if err != nil {
+				d.fail(path, err)
+			}
+			return
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		var pv = reflect.New(t.Elem())
+		var n = len(d.errs)
+		d.decode(pv.Elem(), v, path)
+		if len(d.errs) == n {
+			rv.Set(pv)
+		}
+	case reflect.Struct:
+		m, err := StringMap(v)
+		if err != nil {
+			d.fail(path, err)
+			return
+		}
+		d.decodeStruct(rv, m, path)
+	case reflect.Slice:
+		if s, ok := v.(string); ok && t.Elem().Kind() == reflect.Uint8 {
+			rv.SetBytes([]byte(s))
+			return
+		}
+
+		var sv = elements(v)
+		var pv = reflect.MakeSlice(t, sv.Len(), sv.Len())
+		for i := 0; i < sv.Len(); i++ {
+			d.decode(pv.Index(i), sv.Index(i).Interface(), fmt.Sprintf("%s[%d]", path, i))
+		}
+		rv.Set(pv)
+	case reflect.Array:
+		var sv = elements(v)
+		if sv.Len() > t.Len() {
+			d.fail(path, fmt.Errorf("%d elements exceed array length %d", sv.Len(), t.Len()))
+			return
+		}
+
+		for i := 0; i < sv.Len(); i++ {
+			d.decode(rv.Index(i), sv.Index(i).Interface(), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.Map:
+		var mv = reflect.ValueOf(v)
+		if mv.Kind() != reflect.Map {
+			d.fail(path, &typeConversionError{v: v, t: t.String()})
+			return
+		}
+
+		var pv = reflect.MakeMapWithSize(t, mv.Len())
+		for _, k := range keys(mv) {
+			var kp = fmt.Sprintf("%s[%v]", path, k.Interface())
+
+			pk, err := convert(k.Interface(), t.Key())
+			if err != nil {
+				d.fail(kp, err)
+				continue
+			}
+
+			var ev = reflect.New(t.Elem()).Elem()
+			d.decode(ev, mv.MapIndex(k).Interface(), kp)
+			pv.SetMapIndex(pk, ev)
+		}
+		rv.Set(pv)
+	case reflect.Interface:
+		var pv = reflect.ValueOf(v)
+		if !pv.Type().AssignableTo(t) {
+			d.fail(path, &typeConversionError{v: v, t: t.String()})
+			return
+		}
+		rv.Set(pv)
+	default:
+		d.set(rv, v, path)
+	}
+}
+
+// set convert 'v' with [Convert] rules and store it into 'rv'.
+func (d *decoder) set(rv reflect.Value, v any, path string) {
+	pv, err := convert(v, rv.Type())
+	if err != nil {
+		d.fail(path, err)
+		return
+	}
+	rv.Set(pv)
+}
+
+// elements returns 'v' as slice value, strings are split by [SliceSep]
+// and other non-slice values are wrapped into single element slice.
+func elements(v any) reflect.Value {
+	var rv = reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv
+	case reflect.String:
+		if rv.Len() == 0 {
+			return reflect.ValueOf([]string{})
+		}
+
+		var s = strings.Split(rv.String(), SliceSep)
+		for i := range s {
+			s[i] = strings.TrimSpace(s[i])
+		}
+		return reflect.ValueOf(s)
+	default:
+		return reflect.ValueOf([]any{v})
+	}
+}
+
+// field returns value of 'name' key in 'src', falling back to case
+// insensitive match.
+func field(src map[string]any, name string) (any, bool) {
+	if v, ok := src[name]; ok {
+		return v, true
+	}
+
+	for k, v := range src {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+// join joins field 'name' to 'path'.
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
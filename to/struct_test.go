@@ -0,0 +1,194 @@
+package to
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type (
+	address struct {
+		Street string `json:"street"`
+		Zip    int    `json:"zip"`
+	}
+
+	Audit struct {
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	person struct {
+		Audit
+		Name     string            `json:"name"`
+		Age      uint8             `json:"age"`
+		Active   bool              `json:"active"`
+		Score    *float64          `json:"score"`
+		Tags     []string          `json:"tags"`
+		Ports    [2]int            `json:"ports"`
+		Address  address           `json:"address"`
+		Previous []address         `json:"previous"`
+		Meta     map[string]int    `json:"meta"`
+		Extra    map[string]any    `json:"extra"`
+		Timeout  time.Duration     `json:"timeout"`
+		IP       netip.Addr        `json:"ip"`
+		Level    level             `json:"level"`
+		Ignored  string            `json:"-"`
+		Nickname string            `yaml:"nick"`
+		Labels   map[string]string `json:"labels,omitempty"`
+		secret   string
+	}
+)
+
+func TestStruct(t *testing.T) {
+	t.Parallel()
+	var score = 9.5
+
+	var src = map[string]any{
+		"name":       "Jane",
+		"AGE":        "30",
+		"active":     1,
+		"score":      "9.5",
+		"tags":       "a, b",
+		"ports":      []any{80, "443"},
+		"address":    map[string]any{"street": "Main", "zip": "12345"},
+		"previous":   []any{map[any]any{"street": "Old", "zip": 1}},
+		"meta":       map[string]any{"x": "1"},
+		"extra":      map[string]any{"k": true},
+		"timeout":    "1m",
+		"ip":         "10.0.0.1",
+		"level":      "low",
+		"created_at": "2024-03-01",
+		"Ignored":    "x",
+		"nickname":   "jj",
+		"labels":     map[string]any{"env": "prod"},
+		"secret":     "x",
+	}
+
+	var x = person{
+		Audit:    Audit{CreatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		Name:     "Jane",
+		Age:      30,
+		Active:   true,
+		Score:    &score,
+		Tags:     []string{"a", "b"},
+		Ports:    [2]int{80, 443},
+		Address:  address{Street: "Main", Zip: 12345},
+		Previous: []address{{Street: "Old", Zip: 1}},
+		Meta:     map[string]int{"x": 1},
+		Extra:    map[string]any{"k": true},
+		Timeout:  time.Minute,
+		IP:       netip.MustParseAddr("10.0.0.1"),
+		Level:    1,
+		Nickname: "jj",
+		Labels:   map[string]string{"env": "prod"},
+	}
+
+	var p person
+	if err := Struct(&p, src); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(p, x) {
+		t.Errorf("expected %+v, got %+v", x, p)
+	}
+}
+
+func TestStructError(t *testing.T) {
+	t.Parallel()
+
+	var p person
+	err := Struct(&p, map[string]any{
+		"age":      "-1",
+		"tags":     []any{"a", []int{1}},
+		"address":  map[string]any{"zip": "abc"},
+		"previous": "x",
+		"level":    "medium",
+	})
+
+	var se *StructError
+	if !errors.As(err, &se) {
+		t.Fatalf("expected struct error, got %v", err)
+	}
+
+	var fields []string
+	for _, f := range se.Fields {
+		fields = append(fields, f.Field)
+	}
+
+	var x = []string{"age", "tags[1]", "address.zip", "previous[0]", "level"}
+	if !reflect.DeepEqual(fields, x) {
+		t.Errorf("expected fields %v, got %v", x, fields)
+	}
+
+	if !errors.Is(err, negativeValueError) {
+		t.Errorf("expected error to wrap negative value error")
+	}
+
+	if err := Struct(p, nil); !errors.Is(err, errInvalidTarget) {
+		t.Errorf("expected invalid target error, got %v", err)
+	}
+}
+
+func TestStructWithTag(t *testing.T) {
+	t.Parallel()
+
+	var p person
+	if err := StructWithTag(&p, map[string]any{"nick": "jj"}, "yaml"); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Nickname != "jj" {
+		t.Errorf("expected nickname jj, got %q", p.Nickname)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	t.Parallel()
+
+	var (
+		tags    []string
+		ports   [2]int
+		score   *float64
+		timeout time.Duration
+		created time.Time
+		lvl     level
+	)
+
+	for _, a := range []struct {
+		dst any
+		v   any
+	}{
+		{&tags, "a, b"},
+		{&ports, "80,443"},
+		{&score, "9.5"},
+		{&timeout, "1m"},
+		{&created, "2024-05-01"},
+		{&lvl, "high"},
+	} {
+		if err := Assign(a.dst, a.v); err != nil {
+			t.Fatalf("assign %v: %v", a.v, err)
+		}
+	}
+
+	if !reflect.DeepEqual(tags, []string{"a", "b"}) || ports != [2]int{80, 443} {
+		t.Errorf("expected [a b] and [80 443], got %v and %v", tags, ports)
+	}
+
+	if score == nil || *score != 9.5 || timeout != time.Minute || created.Day() != 1 {
+		t.Errorf("expected 9.5, 1m and first day, got %v, %v and %v", score, timeout, created)
+	}
+
+	if lvl != 2 {
+		t.Errorf("expected level 2, got %v", lvl)
+	}
+
+	var n uint8
+	if err := Assign(&n, "-1"); !errors.Is(err, negativeValueError) {
+		t.Errorf("expected negative value error, got %v", err)
+	}
+
+	if err := Assign(n, "1"); !errors.Is(err, errInvalidPointer) {
+		t.Errorf("expected invalid pointer error, got %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package to
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	d = "time.Duration"
+	t = "time.Time"
+)
+
+// TimeLayouts is list of layouts used by [Time] and [TimeIn] to parse
+// string values when no layout is given. Layouts are tried in order until
+// one of them succeeds.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC850,
+	time.RFC822Z,
+	time.RFC822,
+	time.RubyDate,
+	time.UnixDate,
+	time.ANSIC,
+}
+
+// Duration convert given 'v' to time.Duration type. Strings are parsed
+// with [time.ParseDuration] such as "1h30m", numeric values and numeric
+// strings are treated as number of seconds.
+func Duration(v interface{}) (time.Duration, error) {
+	switch pv := v.(type) {
+	case time.Duration:
+		return pv, nil
+	case nil:
+		return 0, nil
+	case string:
+		pd, err := time.ParseDuration(pv)
+		if err == nil {
+			return pd, nil
+		}
+
+		f, err := strconv.ParseFloat(strings.TrimSpace(pv), 64)
+		if err != nil {
+			return 0, &typeConversionError{v: v, t: d}
+		}
+		return seconds(v, f)
+	}
+
+	f, err := Float64(v)
+	if err != nil {
+		return 0, &typeConversionError{v: v, t: d}
+	}
+	return seconds(v, f)
+}
+
+// seconds convert 'f' number of seconds into time.Duration, 'v' is the
+// original value used for error reporting.
+func seconds(v any, f float64) (time.Duration, error) {
+	var n = f * float64(time.Second)
+	if math.IsNaN(n) || n >= math.MaxInt64 || n <= math.MinInt64 {
+		return 0, &NumError{V: v, T: d, Err: ErrOverflow}
+	}
+	return time.Duration(n), nil
+}
+
+// Time convert given 'v' to time.Time type. Strings are parsed using
+// [TimeLayouts] in UTC, numeric values and numeric strings are treated as
+// Unix time in seconds.
+func Time(v interface{}) (time.Time, error) {
+	return TimeIn(v, time.UTC)
+}
+
+// TimeIn convert given 'v' to time.Time type. Strings without time zone
+// information are interpreted in 'loc' using given 'layouts', or
+// [TimeLayouts] if none given. Numeric values and numeric strings are
+// treated as Unix time in seconds and returned in 'loc'.
+func TimeIn(v interface{}, loc *time.Location, layouts ...string) (time.Time, error) {
+	if len(layouts) == 0 {
+		layouts = TimeLayouts
+	}
+
+	switch pv := v.(type) {
+	case time.Time:
+		return pv, nil
+	case *time.Time:
+		if pv == nil {
+			return time.Time{}, nil
+		}
+		return *pv, nil
+	case string:
+		for _, l := range layouts {
+			pt, err := time.ParseInLocation(l, pv, loc)
+			if err == nil {
+				return pt, nil
+			}
+		}
+
+		if _, err := strconv.ParseFloat(pv, 64); err != nil {
+			return time.Time{}, &typeConversionError{v: v, t: t}
+		}
+	}
+
+	pt, err := UnixTime(v, time.Second)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return pt.In(loc), nil
+}
+
+// maxUnix is the latest Unix time in seconds of time.Time, which counts
+// seconds since year 1 in int64.
+const maxUnix = math.MaxInt64 - 62135596800
+
+// UnixTime convert given 'v' representing number of 'unit' elapsed since
+// January 1, 1970 UTC to time.Time type, e.g. time.Millisecond for Unix
+// time in milliseconds. It accepts any numeric type and numeric strings,
+// the result is in UTC. It returns [NumError] if the time is out of range
+// of time.Time.
+func UnixTime(v interface{}, unit time.Duration) (time.Time, error) {
+	if unit <= 0 {
+		unit = time.Second
+	}
+
+	bv, ok := base(v, false)
+	if !ok {
+		return time.Time{}, &typeConversionError{v: v, t: t}
+	}
+
+	if s, ok := bv.(string); ok {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			bv = i
+		} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+			bv = f
+		} else {
+			return time.Time{}, &typeConversionError{v: v, t: t}
+		}
+	}
+
+	var sec, nsec int64
+
+	switch n := bv.(type) {
+	case int64:
+		switch {
+		case unit%time.Second == 0:
+			var per = int64(unit / time.Second)
+			if n > maxUnix/per || n < math.MinInt64/per {
+				return time.Time{}, &NumError{V: v, T: t, Err: ErrOverflow}
+			}
+			sec = n * per
+		case time.Second%unit == 0:
+			var per = int64(time.Second / unit)
+			sec, nsec = n/per, n%per*int64(unit)
+		default:
+			return UnixTime(float64(n), unit)
+		}
+	case uint64:
+		if n > math.MaxInt64 {
+			return time.Time{}, &NumError{V: v, T: t, Err: ErrOverflow}
+		}
+		return UnixTime(int64(n), unit)
+	case float32:
+		return UnixTime(float64(n), unit)
+	case float64:
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return time.Time{}, &NumError{V: v, T: t, Err: ErrOverflow}
+		}
+
+		var s = n * unit.Seconds()
+		if s >= maxUnix || s < math.MinInt64 {
+			return time.Time{}, &NumError{V: v, T: t, Err: ErrOverflow}
+		}
+
+		var i, frac = math.Modf(s)
+		sec, nsec = int64(i), int64(math.Round(frac*1e9))
+	default:
+		return time.Time{}, &typeConversionError{v: v, t: t}
+	}
+	return time.Unix(sec, nsec).UTC(), nil
+}
@@ -0,0 +1,142 @@
+package to
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	t.Parallel()
+	var x = time.Date(2024, time.March, 1, 10, 30, 0, 0, time.UTC)
+
+	var tests = []struct {
+		name  string
+		input any
+		err   bool
+	}{
+		{"rfc3339", "2024-03-01T10:30:00Z", false},
+		{"rfc3339 offset", "2024-03-01T17:30:00+07:00", false},
+		{"datetime", "2024-03-01 10:30:00", false},
+		{"rfc1123", "Fri, 01 Mar 2024 10:30:00 UTC", false},
+		{"unix int", x.Unix(), false},
+		{"unix uint32", uint32(x.Unix()), false},
+		{"unix float", float64(x.Unix()), false},
+		{"unix string", "1709289000", false},
+		{"unix json.Number", json.Number("1709289000"), false},
+		{"time", x, false},
+		{"fail string", "yesterday", true},
+		{"fail bool", true, true},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			value, err := Time(test.input)
+			if test.err {
+				if err == nil {
+					t.Errorf("expected error, got %v", value)
+				}
+				return
+			}
+
+			if err != nil || !value.Equal(x) {
+				t.Errorf("expected %v, got %v, %v", x, value, err)
+			}
+		})
+	}
+}
+
+func TestTimeIn(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	value, err := TimeIn("01/03/2024 10:30", loc, "02/01/2006 15:04")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if x := time.Date(2024, time.March, 1, 3, 30, 0, 0, time.UTC); !value.Equal(x) {
+		t.Errorf("expected %v, got %v", x, value)
+	}
+}
+
+func TestUnixTime(t *testing.T) {
+	t.Parallel()
+	var x = time.Date(2024, time.March, 1, 10, 30, 0, 500_000_000, time.UTC)
+
+	var tests = []struct {
+		input any
+		unit  time.Duration
+	}{
+		{int64(1709289000500), time.Millisecond},
+		{"1709289000500000", time.Microsecond},
+		{uint64(1709289000500000000), time.Nanosecond},
+		{1709289000.5, time.Second},
+		{float32(0.5), time.Second},
+	}
+	for _, test := range tests {
+		value, err := UnixTime(test.input, test.unit)
+		if err != nil {
+			t.Errorf("%v: unexpected error %v", test.input, err)
+			continue
+		}
+
+		if test.input == float32(0.5) {
+			x = time.Unix(0, 500_000_000).UTC()
+		}
+
+		if !value.Equal(x) {
+			t.Errorf("%v: expected %v, got %v", test.input, x, value)
+		}
+	}
+
+	var overflows = []struct {
+		input any
+		unit  time.Duration
+	}{
+		{int64(math.MaxInt64), time.Second},
+		{int64(math.MinInt64 / 2), time.Hour},
+		{"9223372036854775807", time.Second},
+		{1e300, time.Millisecond},
+		{-1e19, time.Second},
+	}
+	for _, test := range overflows {
+		if _, err := UnixTime(test.input, test.unit); !errors.Is(err, ErrOverflow) {
+			t.Errorf("%v %v: expected overflow error, got %v", test.input, test.unit, err)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+	var tests = []struct {
+		input any
+		x     time.Duration
+	}{
+		{"1h30m", 90 * time.Minute},
+		{"90", 90 * time.Second},
+		{1.5, 1500 * time.Millisecond},
+		{int8(2), 2 * time.Second},
+		{time.Minute, time.Minute},
+		{nil, 0},
+	}
+	for _, test := range tests {
+		value, err := Duration(test.input)
+		if err != nil || value != test.x {
+			t.Errorf("%v: expected %v, got %v, %v", test.input, test.x, value, err)
+		}
+	}
+
+	if _, err := Duration("soon"); err == nil {
+		t.Errorf("expected error for invalid duration")
+	}
+
+	if _, err := Duration(1e300); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected overflow error, got %v", err)
+	}
+}
@@ -1,7 +1,10 @@
 package to_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/n4x2/zoo/to"
 )
@@ -159,3 +162,167 @@ func ExampleString() {
 	// Output:
 	// false type of string
 }
+
+func ExampleStrictInt8() {
+	_, err := to.StrictInt8(300)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, to.ErrOverflow))
+
+	i, err := to.StrictInt8("-12")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v type of %T", i, i)
+	// Output:
+	// unable to convert 300 type of int to int8: value out of range
+	// true
+	// -12 type of int8
+}
+
+func ExampleStrictInt() {
+	_, err := to.StrictInt(1.9)
+	fmt.Println(errors.Is(err, to.ErrPrecisionLoss))
+	// Output:
+	// true
+}
+
+type Age int
+
+func ExampleConvert() {
+	a, err := to.Convert[Age]("30")
+	if err != nil {
+		panic(err)
+	}
+
+	n, err := to.Convert[int64](json.Number("42"))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v type of %T\n", a, a)
+	fmt.Printf("%v type of %T", n, n)
+	// Output:
+	// 30 type of to_test.Age
+	// 42 type of int64
+}
+
+type Celsius struct {
+	Degree float64
+}
+
+func ExampleRegister() {
+	to.Register(func(c Celsius) (float64, error) {
+		return c.Degree*9/5 + 32, nil
+	})
+
+	f, err := to.Float64(Celsius{Degree: 100})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v type of %T", f, f)
+	// Output:
+	// 212 type of float64
+}
+
+func ExampleTime() {
+	for _, v := range []any{"2024-03-01T17:30:00+07:00", "2024-03-01", 1709289000} {
+		t, err := to.Time(v)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(t.UTC())
+	}
+	// Output:
+	// 2024-03-01 10:30:00 +0000 UTC
+	// 2024-03-01 00:00:00 +0000 UTC
+	// 2024-03-01 10:30:00 +0000 UTC
+}
+
+func ExampleUnixTime() {
+	t, err := to.UnixTime("1709289000500", time.Millisecond)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(t)
+	// Output:
+	// 2024-03-01 10:30:00.5 +0000 UTC
+}
+
+func ExampleDuration() {
+	for _, v := range []any{"1h30m", 90, "2.5"} {
+		d, err := to.Duration(v)
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(d)
+	}
+	// Output:
+	// 1h30m0s
+	// 1m30s
+	// 2.5s
+}
+
+func ExampleSlice() {
+	s, err := to.Slice[int]("1, 2, 3")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v type of %T\n", s, s)
+
+	_, err = to.Slice[int]([]any{1, "2", "three"})
+	fmt.Println(err)
+	// Output:
+	// [1 2 3] type of []int
+	// index 2: unable to convert three type of string to int
+}
+
+func ExampleMap() {
+	m, err := to.Map[string, float64](map[string]any{"price": "9.99", "qty": 3})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v type of %T", m, m)
+	// Output:
+	// map[price:9.99 qty:3] type of map[string]float64
+}
+
+type Order struct {
+	ID       int64     `json:"id"`
+	Total    float64   `json:"total"`
+	Paid     bool      `json:"paid"`
+	Items    []string  `json:"items"`
+	Created  time.Time `json:"created"`
+	Customer struct {
+		Name string `json:"name"`
+	} `json:"customer"`
+}
+
+func ExampleStruct() {
+	var o Order
+	err := to.Struct(&o, map[string]any{
+		"id":       "1001",
+		"total":    "25.5",
+		"paid":     "true",
+		"items":    "apple,banana",
+		"created":  "2024-03-01",
+		"customer": map[string]any{"name": "Jane"},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", o)
+
+	err = to.Struct(&o, map[string]any{"id": "x", "total": []int{1}})
+	fmt.Println(err)
+	// Output:
+	// {ID:1001 Total:25.5 Paid:true Items:[apple banana] Created:2024-03-01 00:00:00 +0000 UTC Customer:{Name:Jane}}
+	// unable to decode struct: id: unable to convert x type of string to int64; total: unable to convert [1] type of []int to float64
+}
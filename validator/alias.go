@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Indicates that aliases refer to each other.
+var errAliasCycle = errors.New("alias cycle")
+
+// alias holds validator tag expression registered by
+// [Validator.RegisterAlias].
+type alias struct {
+	expr string // The expression.
+	msg  string // Message replacing messages of failed rules, if any.
+}
+
+// RegisterAlias registers alias 'n' of validator tag expression 'expr',
+// e.g. "username" of "alphadash|lowercase", so tags can refer to the
+// expression by its name. Aliases may refer to other aliases, they are
+// expanded when rules are compiled. Messages are reported by rules of the
+// expression, unless 'msg' is not empty, which then replaces messages of
+// failed rules. It returns an error if a rule or alias named 'n' already
+// exists or the expression is malformed.
+func (r *Validator) RegisterAlias(n, expr, msg string) error {
+	if _, exists := r.rules[n]; exists || r.alias(n) || n == SkipTag || n == OrKeyword || n == NotKeyword {
+		return errors.New("rule " + n + " already exists")
+	}
+
+	// Rules are checked on expansion, as aliases may refer to aliases
+	// registered later.
+	_, err := ParseExprFunc(expr, func(string) (Tag, error) {
+		return Tag{}, nil
+	})
+	if err != nil {
+		return fmt.Errorf("alias %s: %w", n, err)
+	}
+
+	r.aliases[n] = alias{expr: expr, msg: msg}
+	return nil
+}
+
+// alias checks if alias 'n' is registered.
+func (r *Validator) alias(n string) bool {
+	_, ok := r.aliases[n]
+	return ok
+}
+
+// parseExpr parse validator tag 'v' like [ParseExpr], expanding aliases
+// registered by [Validator.RegisterAlias]. It returns an error if the
+// expression of tag or alias fails to parse, or aliases refer to each
+// other.
+func (r *Validator) parseExpr(v string) (*Expr, error) {
+	return r.expand(v, nil)
+}
+
+// expand parse validator tag 'v' expanding aliases, 'stack' holds names
+// of aliases being expanded.
+func (r *Validator) expand(v string, stack []string) (*Expr, error) {
+	e, err := ParseExprFunc(v, func(s string) (Tag, error) {
+		if r.alias(s) {
+			return Tag{N: s}, nil
+		}
+		return parseRule(s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, r.aliasNodes(e, stack)
+}
+
+// aliasNodes replaces alias rules of expression 'e' with [OpAlias] nodes
+// holding their expanded expression.
+func (r *Validator) aliasNodes(e *Expr, stack []string) error {
+	if e.Op != OpRule {
+		for _, x := range e.X {
+			if err := r.aliasNodes(x, stack); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var n = e.Tag.N
+	if !r.alias(n) {
+		return nil
+	}
+
+	for _, sn := range stack {
+		if sn == n {
+			return fmt.Errorf("%w: %s -> %s", errAliasCycle, strings.Join(stack, " -> "), n)
+		}
+	}
+
+	x, err := r.expand(r.aliases[n].expr, append(stack[:len(stack):len(stack)], n))
+	if err != nil {
+		if errors.Is(err, errAliasCycle) {
+			return err
+		}
+		return fmt.Errorf("alias %s: %w", n, err)
+	}
+
+	e.Op, e.X = OpAlias, []*Expr{x}
+	return nil
+}
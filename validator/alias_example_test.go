@@ -0,0 +1,47 @@
+package validator_test
+
+import (
+	"fmt"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Member struct {
+	Username string `json:"username" v:"username"`
+	Referrer string `json:"referrer" v:"-|handle"`
+}
+
+func ExampleValidator_RegisterAlias() {
+	v := validator.New()
+	if err := v.RegisterAlias("username", "alphadash|lowercase", ""); err != nil {
+		panic(err)
+	}
+
+	// Messages of rules of "handle" are summarized by its message.
+	if err := v.RegisterAlias("handle", "username or email", "must be a username or an email address"); err != nil {
+		panic(err)
+	}
+
+	result, err := v.ValidateStruct(Member{Username: "Jane Doe", Referrer: "@jane"})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range result {
+		fmt.Println(r.F, r.E)
+	}
+	// Output:
+	// username [must be alphaNeric characters, dash, and underscore must be lowercase characters]
+	// referrer [must be a username or an email address]
+}
+
+func ExampleValidator_RegisterAlias_cycle() {
+	v := validator.New()
+	_ = v.RegisterAlias("code", "alpha|short", "")
+	_ = v.RegisterAlias("short", "lowercase|code", "")
+
+	_, err := v.ValidateMap(map[string]any{"ref": "abc"}, map[string]string{"ref": "code"})
+	fmt.Println(err)
+	// Output:
+	// validator: field ref: alias cycle: code -> short -> code
+}
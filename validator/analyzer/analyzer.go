@@ -0,0 +1,467 @@
+// Package analyzer defines an analysis that reports invalid validator tags.
+//
+// It checks every rule of "v" struct tag expressions against the built-in
+// rules of package validator: unknown tag names, parameter counts, numeric
+// parameters, password and email policies, country codes of phone rules
+// and whether the rule supports kind of the field, e.g. "email" on an int
+// field. Malformed expressions are reported as well, so are modifier tags
+// and default tag values that cannot be converted into the field type in
+// structs having validator tags, as other libraries use tags of the same
+// name. The analyzer module is used from a checkout of the repository,
+// like the zoo-vet command running it with go vet:
+//
+//	go vet -vettool=$(which zoo-vet) ./...
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/n4x2/zoo/is"
+	"github.com/n4x2/zoo/regex"
+	"github.com/n4x2/zoo/to"
+	"github.com/n4x2/zoo/validator"
+)
+
+// Analyzer reports invalid validator tags.
+var Analyzer = &analysis.Analyzer{
+	Name:     "vtag",
+	Doc:      "check validator struct tags\n\nReports unknown rules, invalid parameters and rules not accepting the field type.",
+	URL:      "https://pkg.go.dev/github.com/n4x2/zoo/validator/analyzer",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// rules, funcs and mods are comma-separated lists of custom rule or alias
+// names, expression function names and modifier names accepted by the
+// analyzer, as they are registered at runtime.
+var rules, funcs, mods string
+
+func init() {
+	Analyzer.Flags.StringVar(&rules, "rules", "", "comma-separated list of custom rule and alias names")
+	Analyzer.Flags.StringVar(&funcs, "funcs", "", "comma-separated list of custom expression function names")
+	Analyzer.Flags.StringVar(&mods, "mods", "", "comma-separated list of custom modifier names")
+}
+
+// names returns set of comma-separated names 'list'.
+func names(list string) map[string]bool {
+	var m = make(map[string]bool)
+	for _, n := range strings.Split(list, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			m[n] = true
+		}
+	}
+	return m
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	var custom = names(rules)
+
+	var ins = pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(n ast.Node) {
+		// Modifier and default tags are common names, so they are only
+		// checked in structs having validator tags.
+		var zoo = tagged(n.(*ast.StructType))
+
+		for _, f := range n.(*ast.StructType).Fields.List {
+			if f.Tag == nil {
+				continue
+			}
+
+			tv, err := strconv.Unquote(f.Tag.Value)
+			if err != nil {
+				continue
+			}
+
+			var t = pass.TypesInfo.TypeOf(f.Type)
+			if mt, ok := reflect.StructTag(tv).Lookup(validator.ModTag); ok && zoo {
+				if msg := checkMod(mt, t); msg != "" {
+					pass.Reportf(f.Tag.Pos(), "modifier tag %q: %s", mt, msg)
+				}
+			}
+
+			if dt, ok := reflect.StructTag(tv).Lookup(validator.DefaultTag); ok && zoo {
+				if msg := checkDefault(dt, t); msg != "" {
+					pass.Reportf(f.Tag.Pos(), "default tag %q: %s", dt, msg)
+				}
+			}
+
+			vt, ok := reflect.StructTag(tv).Lookup(validator.ValidatorTag)
+			if !ok {
+				continue
+			}
+
+			if vt == "" {
+				pass.Reportf(f.Tag.Pos(), "empty validator tag")
+				continue
+			}
+
+			_, err = validator.ParseExprFunc(vt, func(part string) (validator.Tag, error) {
+				if msg := check(part, t, custom); msg != "" {
+					pass.Reportf(f.Tag.Pos(), "validator tag %q: %s", part, msg)
+				}
+				return validator.Tag{}, nil
+			})
+			if err != nil {
+				pass.Reportf(f.Tag.Pos(), "validator tag %q: %v", vt, err)
+			}
+		}
+	})
+	return nil, nil
+}
+
+// tagged checks if any field of struct 'st' has validator tag.
+func tagged(st *ast.StructType) bool {
+	for _, f := range st.Fields.List {
+		if f.Tag == nil {
+			continue
+		}
+
+		tv, err := strconv.Unquote(f.Tag.Value)
+		if err != nil {
+			continue
+		}
+
+		if _, ok := reflect.StructTag(tv).Lookup(validator.ValidatorTag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// check checks single validator tag 'tag' of field type 't'. It returns
+// description of the problem, or empty string if the tag is valid.
+func check(tag string, t types.Type, custom map[string]bool) string {
+	n, p, hasp := strings.Cut(tag, validator.PairSep)
+	if n == validator.SkipTag {
+		if hasp {
+			return "rule " + n + " does not accept parameters"
+		}
+		return ""
+	}
+
+	d, ok := validator.R[n]
+	if !ok {
+		if custom[n] {
+			return ""
+		}
+		return "unknown rule " + n
+	}
+
+	var params []string
+	if hasp {
+		if d.Maxp == 0 {
+			return "rule " + n + " does not accept parameters"
+		}
+
+		params = strings.Split(p, validator.ParamSep)
+		if d.Raw {
+			params = []string{p}
+		}
+
+		if d.Maxp != -1 && len(params) != d.Maxp {
+			return "rule " + n + " accepts " + strconv.Itoa(d.Maxp) + " parameter(s), got " + strconv.Itoa(len(params))
+		}
+
+		for _, pv := range params {
+			if d.N && !regex.Numeric.MatchString(pv) {
+				return "rule " + n + " requires numeric parameters, got " + strconv.Quote(pv)
+			}
+		}
+	}
+
+	if n == "phone" {
+		if len(params) == 0 {
+			return "rule " + n + " requires country code parameter"
+		}
+
+		if _, ok := is.CallingCode(params[0]); !ok {
+			return "rule " + n + " requires country code parameter, got " + strconv.Quote(params[0])
+		}
+	}
+
+	switch d.Fn.(type) {
+	case func(*validator.Program, any, validator.Scope) (bool, error):
+		for _, pv := range params {
+			if _, err := validator.ParseProgram(pv); err != nil && !unknown(err, names(funcs)) {
+				return "rule " + n + ": " + err.Error()
+			}
+		}
+	case func(string, is.PasswordPolicy) []string:
+		if _, err := validator.ParsePasswordPolicy(params...); err != nil {
+			return "rule " + n + ": " + err.Error()
+		}
+	case func(string, is.EmailPolicy) bool:
+		if _, err := validator.ParseEmailPolicy(params...); err != nil {
+			return "rule " + n + ": " + err.Error()
+		}
+	case func(time.Time, time.Time) bool:
+		for _, pv := range params {
+			if pv == validator.NowParam {
+				continue
+			}
+
+			if !parsable(pv) {
+				return "rule " + n + " requires date-time parameter, got " + strconv.Quote(pv)
+			}
+		}
+	case func(time.Time, time.Duration) bool:
+		for _, pv := range params {
+			if _, err := time.ParseDuration(pv); err != nil {
+				return "rule " + n + " requires duration parameter, got " + strconv.Quote(pv)
+			}
+		}
+	}
+
+	if t == nil || accepts(d.Kinds, t) {
+		return ""
+	}
+	return "rule " + n + " does not support " + t.String()
+}
+
+// checkMod checks modifier tag 'tag' of field type 't'. It returns
+// description of the problem, or empty string if the tag is valid.
+func checkMod(tag string, t types.Type) string {
+	var custom = names(mods)
+	for _, m := range strings.Split(tag, validator.TagSep) {
+		n, _, _ := strings.Cut(strings.TrimSpace(m), validator.PairSep)
+		if _, ok := validator.Transforms[n]; !ok && !custom[n] {
+			return "unknown modifier " + n
+		}
+	}
+
+	if t == nil || stringElem(t) {
+		return ""
+	}
+	return "modifiers do not support " + t.String()
+}
+
+// stringElem checks if 't' is string type, or pointer, slice or array
+// of string types.
+func stringElem(t types.Type) bool {
+	for {
+		switch u := t.Underlying().(type) {
+		case *types.Pointer:
+			t = u.Elem()
+		case *types.Slice:
+			t = u.Elem()
+		case *types.Array:
+			t = u.Elem()
+		case *types.Basic:
+			return u.Info()&types.IsString != 0
+		default:
+			return false
+		}
+	}
+}
+
+// unknown checks if expression error 'err' is caused by calling one of
+// custom functions 'fns', which are only known at runtime.
+func unknown(err error, fns map[string]bool) bool {
+	for n := range fns {
+		if strings.HasSuffix(err.Error(), "unknown function "+n) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsable checks if 'v' is parsable in one of [validator.TimeLayouts].
+func parsable(v string) bool {
+	for _, l := range validator.TimeLayouts {
+		if _, err := time.Parse(l, v); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// accepts checks if a rule declaring 'kinds' supports values of type 't'
+// like validator does when compiling rules of a struct, pointer types are
+// checked by their element type and values unwrapped by validator such as
+// sql.NullString are accepted.
+func accepts(kinds []reflect.Kind, t types.Type) bool {
+	var k = kind(deref(t))
+	if kinds == nil || k == reflect.Interface || unwrapped(t) {
+		return true
+	}
+
+	for _, rk := range kinds {
+		if k == rk {
+			return true
+		}
+	}
+	return false
+}
+
+// unwrapped checks if values of type 't' are unwrapped by validator
+// before rules are applied: it has method Value of driver.Valuer,
+// MarshalText of encoding.TextMarshaler or String of fmt.Stringer. time.Time
+// and pointer to it are validated by date-time rules only.
+func unwrapped(t types.Type) bool {
+	if isTime(t) {
+		return false
+	}
+
+	var ms = types.NewMethodSet(t)
+	for _, n := range []string{"Value", "MarshalText", "String"} {
+		if ms.Lookup(nil, n) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDefault checks value 'v' of default tag of field type 't' like
+// validator does when compiling rules. It returns description of the
+// problem, or empty string if the value is valid or the type is only
+// known at runtime, see [reflectType].
+func checkDefault(v string, t types.Type) string {
+	rt, ok := reflectType(t)
+	if !ok || v == "" && rt.Kind() == reflect.Pointer {
+		return ""
+	}
+
+	if err := to.Assign(reflect.New(rt).Interface(), v); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// reflectType returns reflect type converting values like type 't': basic
+// types, time.Time, time.Duration and pointers and slices of them. It
+// returns false for other types and named types having methods, which may
+// convert values themselves, e.g. by encoding.TextUnmarshaler.
+func reflectType(t types.Type) (reflect.Type, bool) {
+	if n, ok := t.(*types.Named); ok {
+		var o = n.Obj()
+		if o.Pkg() != nil && o.Pkg().Path() == "time" {
+			switch o.Name() {
+			case "Time":
+				return reflect.TypeOf(time.Time{}), true
+			case "Duration":
+				return reflect.TypeOf(time.Duration(0)), true
+			}
+		}
+
+		if types.NewMethodSet(types.NewPointer(t)).Len() > 0 {
+			return nil, false
+		}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		rt, ok := basicTypes[u.Kind()]
+		return rt, ok
+	case *types.Pointer:
+		if et, ok := reflectType(u.Elem()); ok {
+			return reflect.PointerTo(et), true
+		}
+	case *types.Slice:
+		if et, ok := reflectType(u.Elem()); ok {
+			return reflect.SliceOf(et), true
+		}
+	}
+	return nil, false
+}
+
+// basicTypes maps basic types into their reflect type.
+var basicTypes = map[types.BasicKind]reflect.Type{
+	types.Bool:    reflect.TypeOf(false),
+	types.Int:     reflect.TypeOf(0),
+	types.Int8:    reflect.TypeOf(int8(0)),
+	types.Int16:   reflect.TypeOf(int16(0)),
+	types.Int32:   reflect.TypeOf(int32(0)),
+	types.Int64:   reflect.TypeOf(int64(0)),
+	types.Uint:    reflect.TypeOf(uint(0)),
+	types.Uint8:   reflect.TypeOf(uint8(0)),
+	types.Uint16:  reflect.TypeOf(uint16(0)),
+	types.Uint32:  reflect.TypeOf(uint32(0)),
+	types.Uint64:  reflect.TypeOf(uint64(0)),
+	types.Float32: reflect.TypeOf(float32(0)),
+	types.Float64: reflect.TypeOf(float64(0)),
+	types.String:  reflect.TypeOf(""),
+}
+
+// basicKinds maps basic types into their reflect kind.
+var basicKinds = map[types.BasicKind]reflect.Kind{
+	types.Bool:          reflect.Bool,
+	types.Int:           reflect.Int,
+	types.Int8:          reflect.Int8,
+	types.Int16:         reflect.Int16,
+	types.Int32:         reflect.Int32,
+	types.Int64:         reflect.Int64,
+	types.Uint:          reflect.Uint,
+	types.Uint8:         reflect.Uint8,
+	types.Uint16:        reflect.Uint16,
+	types.Uint32:        reflect.Uint32,
+	types.Uint64:        reflect.Uint64,
+	types.Uintptr:       reflect.Uintptr,
+	types.Float32:       reflect.Float32,
+	types.Float64:       reflect.Float64,
+	types.Complex64:     reflect.Complex64,
+	types.Complex128:    reflect.Complex128,
+	types.String:        reflect.String,
+	types.UnsafePointer: reflect.UnsafePointer,
+}
+
+// deref returns element type of pointer type 't', dereferenced until
+// other type.
+func deref(t types.Type) types.Type {
+	for {
+		p, ok := t.Underlying().(*types.Pointer)
+		if !ok {
+			return t
+		}
+		t = p.Elem()
+	}
+}
+
+// kind returns reflect kind of values of type 't'.
+func kind(t types.Type) reflect.Kind {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		return basicKinds[u.Kind()]
+	case *types.Pointer:
+		return reflect.Pointer
+	case *types.Struct:
+		return reflect.Struct
+	case *types.Slice:
+		return reflect.Slice
+	case *types.Array:
+		return reflect.Array
+	case *types.Map:
+		return reflect.Map
+	case *types.Chan:
+		return reflect.Chan
+	case *types.Signature:
+		return reflect.Func
+	case *types.Interface:
+		return reflect.Interface
+	default:
+		return reflect.Invalid
+	}
+}
+
+// isTime checks if 't' is time.Time or pointer to it.
+func isTime(t types.Type) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	n, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	var o = n.Obj()
+	return o.Pkg() != nil && o.Pkg().Path() == "time" && o.Name() == "Time"
+}
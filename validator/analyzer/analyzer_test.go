@@ -0,0 +1,24 @@
+package analyzer_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/n4x2/zoo/validator/analyzer"
+)
+
+func TestAnalyzer(t *testing.T) {
+	if err := analyzer.Analyzer.Flags.Set("rules", "even"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := analyzer.Analyzer.Flags.Set("funcs", "even"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := analyzer.Analyzer.Flags.Set("mods", "slug"); err != nil {
+		t.Fatal(err)
+	}
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "a")
+}
@@ -0,0 +1,20 @@
+module github.com/n4x2/zoo/validator/analyzer
+
+go 1.25.0
+
+require (
+	github.com/n4x2/zoo v0.0.0
+	golang.org/x/tools v0.44.0
+)
+
+require (
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/net v0.53.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
+	golang.org/x/text v0.36.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// The analyzer is used from a checkout of the repository, zoo is replaced
+// with its directory.
+replace github.com/n4x2/zoo => ../..
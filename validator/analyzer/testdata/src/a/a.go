@@ -0,0 +1,76 @@
+package a
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Name string
+
+type Level struct{ n int }
+
+func (l Level) String() string { return "low" }
+
+type Valid struct {
+	Email   string         `json:"email" v:"email|lowercase"`
+	Age     int            `v:"gte:18|lt:65"`
+	Score   float32        `v:"range:0,10"`
+	Tier    string         `v:"-|enum:basic,gold"`
+	Day     string         `v:"datetime:2006-01-02"`
+	Start   time.Time      `v:"after:2020-01-01|before:now"`
+	End     *time.Time     `v:"within:24h"`
+	Any     any            `v:"alpha"`
+	Name    Name           `v:"alpha"`
+	Even    int            `v:"even"`
+	Total   string         `v:"decimal:8,2|gt:0"`
+	Code    string         `v:"enum:1,2"`
+	Ref     string         `v:"(uuid or ulid)|not lowercase"`
+	Price   float64        `v:"gt:0|expr:this <= .Score * 2 || even(this, 2)"`
+	Secret  string         `v:"password:min=10,upper,forbid"`
+	Mobile  string         `v:"phone:ID or e164"`
+	Mail    string         `v:"email:html5,allow=example.com"`
+	Tags    []string       `mod:"trim|lower|slug"`
+	Nick    *string        `mod:"trim|default:anon"`
+	Backup  sql.NullString `v:"email"`
+	Owner   *string        `v:"required"`
+	Contact *string        `v:"email"`
+	Level   Level          `v:"enum:low,high"`
+	Limit   int            `default:"10"`
+	Wait    time.Duration  `default:"1h30m"`
+	Labels  []string       `default:"a,b"`
+	Parent  *Valid         `default:""`
+	Ignored string
+}
+
+type Invalid struct {
+	A string    `v:"lowercse"`           // want `validator tag "lowercse": unknown rule lowercse`
+	B int       `v:"gt:abc"`             // want `validator tag "gt:abc": rule gt requires numeric parameters, got "abc"`
+	C int       `v:"range:1"`            // want `validator tag "range:1": rule range accepts 2 parameter\(s\), got 1`
+	D string    `v:"email:strict"`       // want `validator tag "email:strict": rule email: invalid email policy: strict`
+	E int       `v:"email"`              // want `validator tag "email": rule email does not support int`
+	G bool      `v:"gt:1"`               // want `validator tag "gt:1": rule gt does not support bool`
+	Z *bool     `v:"gt:1"`               // want `validator tag "gt:1": rule gt does not support \*bool`
+	H string    `v:"decimal:a,2"`        // want `validator tag "decimal:a,2": rule decimal requires numeric parameters, got "a"`
+	I time.Time `v:"before:tomorrow"`    // want `validator tag "before:tomorrow": rule before requires date-time parameter, got "tomorrow"`
+	X time.Time `v:"datetime:2006"`      // want `validator tag "datetime:2006": rule datetime does not support time.Time`
+	J bool      `v:"within:1d"`          // want `validator tag "within:1d": rule within requires duration parameter, got "1d"`
+	K string    `v:""`                   // want `empty validator tag`
+	L string    `v:"-:x"`                // want `validator tag "-:x": rule - does not accept parameters`
+	N string    `v:"(uuid or ulid"`      // want `validator tag "\(uuid or ulid": invalid tag expression: missing \) at 13`
+	O int       `v:"not (gt:1 or uuid)"` // want `validator tag "uuid": rule uuid does not support int`
+	P float64   `v:"expr:this <="`       // want `validator tag "expr:this <=": rule expr: syntax error at 7: unexpected end of expression`
+	Q string    `v:"expr:odd(this)"`     // want `validator tag "expr:odd\(this\)": rule expr: syntax error at 0: unknown function odd`
+	R string    `v:"password:max=3"`     // want `validator tag "password:max=3": rule password: invalid password policy: max=3`
+	S string    `v:"phone:XY"`           // want `validator tag "phone:XY": rule phone requires country code parameter, got "XY"`
+	T string    `v:"phone"`              // want `validator tag "phone": rule phone requires country code parameter`
+	U string    `v:"-" mod:"trim|lowr"`  // want `modifier tag "trim\|lowr": unknown modifier lowr`
+	W int       `mod:"trim"`             // want `modifier tag "trim": modifiers do not support int`
+	Y int       `default:"ten"`          // want `default tag "ten": unable to convert ten type of string to int`
+	M []string  `v:"alpha|uppercase"`    // want `validator tag "alpha": rule alpha does not support \[\]string` `validator tag "uppercase": rule uppercase does not support \[\]string`
+}
+
+// Other has tags of other libraries named like modifier and default tags.
+type Other struct {
+	Size  int    `default:"large"`
+	Label string `mod:"readonly"`
+}
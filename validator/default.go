@@ -0,0 +1,44 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/n4x2/zoo/to"
+)
+
+// DefaultTag is tag of value assigned to zero fields before validation,
+// e.g. `default:"10"`, see [Validator.Modify]. Values are converted into
+// field type like [to.Assign] does, e.g. "a,b" into []string or "1h" into
+// time.Duration. Empty value of pointer field allocates its element, so
+// defaults of nested struct are assigned as well.
+const DefaultTag = "default"
+
+// defaultValue checks if value 'v' of [DefaultTag] can be assigned to
+// field of type 't'. It returns an error if conversion fails.
+func defaultValue(t reflect.Type, v string) error {
+	if v == "" && t.Kind() == reflect.Pointer {
+		return nil
+	}
+
+	if err := to.Assign(reflect.New(t).Interface(), v); err != nil {
+		return fmt.Errorf("%s: %w", DefaultTag, err)
+	}
+	return nil
+}
+
+// assignDefault assigns value 'v' of [DefaultTag] to addressable field
+// 'fv' if it is zero.
+func assignDefault(fv reflect.Value, v string) {
+	if !fv.IsZero() {
+		return
+	}
+
+	if v == "" && fv.Kind() == reflect.Pointer {
+		fv.Set(reflect.New(fv.Type().Elem()))
+		return
+	}
+
+	// Conversion is checked when rules are compiled.
+	_ = to.Assign(fv.Addr().Interface(), v)
+}
@@ -0,0 +1,39 @@
+package validator_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Limits struct {
+	Burst int `json:"burst" v:"gte:1" default:"10"`
+}
+
+type Server struct {
+	Port    int           `json:"port" v:"gte:1|lte:65535" default:"8080"`
+	Debug   bool          `json:"debug" default:"true"`
+	Ratio   float64       `json:"ratio" default:"0.5"`
+	Hosts   []string      `json:"hosts" default:"localhost,127.0.0.1"`
+	Timeout time.Duration `json:"timeout" default:"30s"`
+	Retries *int          `json:"retries" default:"3"`
+	Limits  *Limits       `json:"limits" default:""`
+}
+
+func ExampleDefaultTag() {
+	var s = Server{Port: 80}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(&s)
+	if err != nil {
+		panic(err)
+	}
+
+	// Fields are assigned only if they are zero.
+	fmt.Println(s.Port, s.Debug, s.Ratio, s.Hosts, s.Timeout, *s.Retries, s.Limits.Burst)
+	fmt.Println(len(result))
+	// Output:
+	// 80 true 0.5 [localhost 127.0.0.1] 30s 3 10
+	// 0
+}
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/n4x2/zoo/is"
+)
+
+// EmailRule is name of rule validating email addresses against policy,
+// e.g. "email:html5,deny=example.com,nodisposable".
+const EmailRule = "email"
+
+// Indicates that a parameter of [EmailRule] is malformed.
+var errEmailParam = errors.New("invalid email policy")
+
+// ParseEmailPolicy parses parameters 'p' of [EmailRule] into policy of
+// [is.EmailAddress]. Parameters are mode and domain requirements:
+//
+//	rfc5322       address specification of RFC 5322
+//	html5         valid email address of HTML standard
+//	simple        local part and dotted domain without spaces
+//	allow=a;b     only domains "a", "b" and their subdomains
+//	deny=a;b      none of domains "a", "b" and their subdomains
+//	nodisposable  none of [is.DisposableDomains]
+//
+// Without mode addresses are checked like [is.Email]. It returns an error
+// if a parameter is unknown, or mode is given more than once.
+func ParseEmailPolicy(p ...string) (is.EmailPolicy, error) {
+	var policy is.EmailPolicy
+	for _, param := range p {
+		var n, v, ok = strings.Cut(strings.TrimSpace(param), "=")
+
+		var err error
+		switch {
+		case n == "allow" && ok:
+			policy.Allow = strings.Split(v, ";")
+		case n == "deny" && ok:
+			policy.Deny = strings.Split(v, ";")
+		case n == "nodisposable" && !ok:
+			policy.NoDisposable = true
+		case (n == is.EmailRFC5322 || n == is.EmailHTML5 || n == is.EmailSimple) && !ok && policy.Mode == "":
+			policy.Mode = n
+		default:
+			err = errEmailParam
+		}
+
+		if err != nil {
+			return is.EmailPolicy{}, fmt.Errorf("%w: %s", errEmailParam, param)
+		}
+	}
+	return policy, nil
+}
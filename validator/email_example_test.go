@@ -0,0 +1,44 @@
+package validator_test
+
+import (
+	"fmt"
+
+	"github.com/n4x2/zoo/is"
+	"github.com/n4x2/zoo/validator"
+)
+
+type Subscriber struct {
+	Email string `json:"email" v:"email:html5,nodisposable"`
+	Work  string `json:"work" v:"email:rfc5322,allow=example.com"`
+}
+
+func Example_email() {
+	var s = Subscriber{Email: "jane@mailinator.com", Work: "jane@sales.example.com"}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(s)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{email [invalid email address]}]
+}
+
+func ExampleParseEmailPolicy() {
+	p, err := validator.ParseEmailPolicy("simple", "deny=example.org;example.net")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(is.EmailAddress("jane@example.com", p))
+	fmt.Println(is.EmailAddress("jane@mail.example.org", p))
+
+	_, err = validator.ParseEmailPolicy("html5", "simple")
+	fmt.Println(err)
+	// Output:
+	// true
+	// false
+	// invalid email policy: simple
+}
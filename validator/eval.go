@@ -0,0 +1,802 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/n4x2/zoo/is"
+)
+
+// ExprRule is name of rule validating value against expression, e.g.
+// "expr:this <= .Price * 0.5", see [ParseProgram].
+const ExprRule = "expr"
+
+// Funcs holds functions callable from expressions of [ExprRule] by
+// default, e.g. "email(.Contact)". Rules of [R] validating strings are
+// added by their names, email addresses are validated with zero policy.
+// Validators copy them when created by [New], others are added to a
+// single validator by [Validator.RegisterFunc].
+var Funcs = map[string]any{}
+
+func init() {
+	for n, d := range R {
+		switch fn := d.Fn.(type) {
+		case func(string) bool:
+			Funcs[n] = fn
+		case func(string, is.EmailPolicy) bool:
+			Funcs[n] = func(v string) bool { return fn(v, is.EmailPolicy{}) }
+		}
+	}
+}
+
+// Scope resolves sibling fields referenced by expressions, e.g. "Price"
+// of ".Price". It returns false if the field is unknown.
+type Scope func(name string) (any, bool)
+
+// Program is compiled expression of [ExprRule], it is safe for concurrent
+// use.
+type Program struct {
+	src    string   // The source.
+	root   *node    // The root node.
+	fields []string // Names of referenced sibling fields.
+}
+
+// node is a node of compiled expression.
+type node struct {
+	op   string        // Operator, or "lit", "this", "field" and "call".
+	v    any           // Value of literal.
+	path []string      // Path of field.
+	fn   reflect.Value // Function of call, invalid for len.
+	x    []*node       // Operands or call arguments.
+}
+
+// Error variables of expression evaluation.
+var (
+	// Indicates that an expression cannot be parsed.
+	errSyntax = errors.New("syntax error")
+	// Indicates that an operand has unsupported type.
+	errOperand = errors.New("invalid operand")
+	// Indicates that a referenced field does not exist.
+	errUnknownField = errors.New("unknown field")
+)
+
+// String returns source of the program.
+func (p *Program) String() string {
+	return p.src
+}
+
+// Fields returns names of sibling fields referenced by the program, e.g.
+// "Price" of ".Price * 0.5".
+func (p *Program) Fields() []string {
+	return p.fields
+}
+
+// ParseProgram compiles expression 'src' with functions of [Funcs], see
+// [Validator.ParseProgram].
+func ParseProgram(src string) (*Program, error) {
+	return parseProgram(src, Funcs)
+}
+
+// MustParseProgram compiles expression 'src' like [ParseProgram] and
+// panics if it fails, e.g. for programs of generated code.
+func MustParseProgram(src string) *Program {
+	p, err := ParseProgram(src)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// ParseProgram compiles expression 'src' of [ExprRule]. The expression
+// refers to the validated value as "this" and to sibling fields as
+// ".Name", nested fields as ".Name.Field". Fields are matched by name or
+// [JSONTag] name. It supports number, string, bool and nil literals,
+// arithmetic "+ - * / %", comparison "== != < <= > >=", logical
+// "&& || !" operators, parentheses, "len()" returning number of
+// characters of a string or elements of a slice, array or map, and
+// calls of functions registered by [Validator.RegisterFunc], e.g.
+// "uuid(.Ref)". Numbers are evaluated as float64, times are compared by
+// comparison operators. Expressions cannot have side effects, so a rule
+// cannot change the validated value. It returns an error if the
+// expression is malformed or calls unknown function.
+func (r *Validator) ParseProgram(src string) (*Program, error) {
+	return parseProgram(src, r.funcs)
+}
+
+// parseProgram compiles expression 'src' calling functions of 'funcs'.
+func parseProgram(src string, funcs map[string]any) (*Program, error) {
+	var p = progParser{src: src, funcs: funcs}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.binary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %s", p.tok.text)
+	}
+	return &Program{src: src, root: root, fields: p.fields}, nil
+}
+
+// RegisterFunc registers function 'fn' callable from expressions of
+// [ExprRule] by name 'n'. Parameters of the function must be strings,
+// numbers, bools or interfaces, it must return single value optionally
+// followed by an error. It returns an error if function name already
+// exists or the function signature is not supported.
+func (r *Validator) RegisterFunc(n string, fn any) error {
+	if _, exists := r.funcs[n]; exists || n == "len" {
+		return errors.New("function " + n + " already exists")
+	}
+
+	if err := callable(reflect.TypeOf(fn)); err != nil {
+		return fmt.Errorf("function %s: %w", n, err)
+	}
+
+	r.funcs[n] = fn
+	return nil
+}
+
+// callable checks if functions of type 't' can be called from
+// expressions.
+func callable(t reflect.Type) error {
+	if t == nil || t.Kind() != reflect.Func || t.IsVariadic() {
+		return errors.New("not a function")
+	}
+
+	for i := 0; i < t.NumIn(); i++ {
+		switch t.In(i).Kind() {
+		case reflect.String, reflect.Bool, reflect.Interface,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			return fmt.Errorf("unsupported parameter type %s", t.In(i))
+		}
+	}
+
+	var errorType = reflect.TypeOf((*error)(nil)).Elem()
+	if t.NumOut() == 0 || t.NumOut() > 2 || (t.NumOut() == 2 && t.Out(1) != errorType) {
+		return errors.New("must return single value optionally followed by an error")
+	}
+	return nil
+}
+
+// Eval evaluates the program with validated value 'this' and sibling
+// fields resolved by 's', which may be nil if the program does not refer
+// to sibling fields. It returns an error if a field is unknown, an
+// operand has unsupported type, a function fails or the result is not
+// bool.
+func (p *Program) Eval(this any, s Scope) (bool, error) {
+	var e = evaluator{this: this, s: s}
+
+	v, err := e.eval(p.root)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: result %v is not bool", errOperand, v)
+	}
+	return b, nil
+}
+
+// evaluator holds state of program evaluation.
+type evaluator struct {
+	this any   // The validated value.
+	s    Scope // Sibling fields.
+}
+
+// eval evaluates node 'n' into normalized value, see [norm].
+func (e *evaluator) eval(n *node) (any, error) {
+	switch n.op {
+	case "lit":
+		return n.v, nil
+	case "this":
+		return norm(e.this), nil
+	case "field":
+		return e.field(n.path)
+	case "call":
+		return e.call(n)
+	case "!":
+		x, err := e.boolean(n.x[0])
+		return !x, err
+	case "neg":
+		x, err := e.eval(n.x[0])
+		if err != nil {
+			return nil, err
+		}
+
+		f, ok := x.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%w: -%v", errOperand, x)
+		}
+		return -f, nil
+	case "&&", "||":
+		x, err := e.boolean(n.x[0])
+		if err != nil || x == (n.op == "||") {
+			return x, err
+		}
+		return e.boolean(n.x[1])
+	}
+
+	x, err := e.eval(n.x[0])
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := e.eval(n.x[1])
+	if err != nil {
+		return nil, err
+	}
+	return binary(n.op, x, y)
+}
+
+// boolean evaluates node 'n' into bool.
+func (e *evaluator) boolean(n *node) (bool, error) {
+	v, err := e.eval(n)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %v is not bool", errOperand, v)
+	}
+	return b, nil
+}
+
+// field resolves sibling field of 'path'.
+func (e *evaluator) field(path []string) (any, error) {
+	if e.s == nil {
+		return nil, fmt.Errorf("%w: .%s", errUnknownField, path[0])
+	}
+
+	v, ok := e.s(path[0])
+	if !ok {
+		return nil, fmt.Errorf("%w: .%s", errUnknownField, path[0])
+	}
+
+	var rv = reflect.ValueOf(v)
+	for i := 1; i < len(path); i++ {
+		if rv, ok = lookup(rv, path[i]); !ok {
+			return nil, fmt.Errorf("%w: .%s", errUnknownField, strings.Join(path[:i+1], "."))
+		}
+	}
+
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil, nil
+	}
+	return norm(rv.Interface()), nil
+}
+
+// call calls function of node 'n'.
+func (e *evaluator) call(n *node) (any, error) {
+	var args = make([]any, len(n.x))
+	for i, x := range n.x {
+		v, err := e.eval(x)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+
+	if !n.fn.IsValid() {
+		return length(args[0])
+	}
+
+	var t = n.fn.Type()
+	var in = make([]reflect.Value, len(args))
+	for i, a := range args {
+		var pt = t.In(i)
+		switch av := reflect.ValueOf(a); {
+		case pt.Kind() == reflect.Interface && a == nil:
+			in[i] = reflect.Zero(pt)
+		case av.IsValid() && av.Type().ConvertibleTo(pt) && (av.Kind() == pt.Kind() || (av.Kind() == reflect.Float64 && numeric(pt.Kind()))):
+			in[i] = av.Convert(pt)
+		case pt.Kind() == reflect.Interface && av.Type().Implements(pt):
+			in[i] = av
+		default:
+			return nil, fmt.Errorf("%w: argument %d of %s: %v", errOperand, i+1, n.v, a)
+		}
+	}
+
+	var out = n.fn.Call(in)
+	if len(out) == 2 && !out[1].IsNil() {
+		return nil, out[1].Interface().(error)
+	}
+	return norm(out[0].Interface()), nil
+}
+
+// length returns number of characters of a string or elements of a
+// slice, array or map 'v'.
+func length(v any) (any, error) {
+	if s, ok := v.(string); ok {
+		return float64(utf8.RuneCountInString(s)), nil
+	}
+
+	var rv = reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return float64(0), nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(rv.Len()), nil
+	default:
+		return nil, fmt.Errorf("%w: len(%v)", errOperand, v)
+	}
+}
+
+// binary applies binary operator 'op' on normalized values 'x' and 'y'.
+func binary(op string, x, y any) (any, error) {
+	switch op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+	}
+
+	switch xv := x.(type) {
+	case float64:
+		yv, ok := y.(float64)
+		if !ok {
+			break
+		}
+
+		switch op {
+		case "+":
+			return xv + yv, nil
+		case "-":
+			return xv - yv, nil
+		case "*":
+			return xv * yv, nil
+		case "/", "%":
+			if yv == 0 {
+				return nil, fmt.Errorf("%w: division by zero", errOperand)
+			}
+
+			if op == "%" {
+				return math.Mod(xv, yv), nil
+			}
+			return xv / yv, nil
+		default:
+			return order(op, xv < yv, xv == yv), nil
+		}
+	case string:
+		yv, ok := y.(string)
+		if !ok {
+			break
+		}
+
+		if op == "+" {
+			return xv + yv, nil
+		}
+
+		if op != "-" && op != "*" && op != "/" && op != "%" {
+			return order(op, xv < yv, xv == yv), nil
+		}
+	case time.Time:
+		yv, ok := y.(time.Time)
+		if !ok || strings.ContainsAny(op, "+-*/%") {
+			break
+		}
+		return order(op, xv.Before(yv), xv.Equal(yv)), nil
+	}
+	return nil, fmt.Errorf("%w: %v %s %v", errOperand, x, op, y)
+}
+
+// order returns result of ordering operator 'op' of operands that are
+// less than or equal each other.
+func order(op string, less, equal bool) bool {
+	switch op {
+	case "<":
+		return less
+	case "<=":
+		return less || equal
+	case ">":
+		return !less && !equal
+	default:
+		return !less
+	}
+}
+
+// equal checks if normalized values 'x' and 'y' are equal, values of
+// different types are not equal.
+func equal(x, y any) bool {
+	if xt, ok := x.(time.Time); ok {
+		yt, ok := y.(time.Time)
+		return ok && xt.Equal(yt)
+	}
+
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	var xv, yv = reflect.ValueOf(x), reflect.ValueOf(y)
+	if xv.Type() != yv.Type() || !xv.Comparable() {
+		return false
+	}
+	return xv.Equal(yv)
+}
+
+// norm normalizes value 'v' for evaluation: pointers are dereferenced,
+// numbers are converted into float64, strings and bools of named types
+// into their kinds. Other values are returned as is.
+func norm(v any) any {
+	var rv = reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	default:
+		return rv.Interface()
+	}
+}
+
+// numeric checks if 'k' is numeric kind.
+func numeric(k reflect.Kind) bool {
+	for _, nk := range NumericKinds {
+		if k == nk {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns exported field named 'n' or by [JSONTag] name 'n' of
+// struct 'rv', or value of key 'n' of map 'rv' with string keys.
+// Pointers are dereferenced. Fields promoted through nil embedded pointers
+// are returned as invalid value.
+func lookup(rv reflect.Value, n string) (reflect.Value, bool) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if idx, ok := fieldIndex(rv.Type(), n); ok {
+			v, _ := rv.FieldByIndexErr(idx)
+			return v, true
+		}
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			break
+		}
+
+		if v := rv.MapIndex(reflect.ValueOf(n).Convert(rv.Type().Key())); v.IsValid() {
+			return v, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldIndex returns index of exported field named 'n' or by [JSONTag]
+// name 'n' of struct type 't'.
+func fieldIndex(t reflect.Type, n string) ([]int, bool) {
+	if f, ok := t.FieldByName(n); ok && f.IsExported() {
+		return f.Index, true
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() && fieldName(t.Field(i)) == n {
+			return []int{i}, true
+		}
+	}
+	return nil, false
+}
+
+// structScope returns scope resolving fields of struct 'rv', fields
+// promoted through nil embedded pointers are absent.
+func structScope(rv reflect.Value) Scope {
+	return func(n string) (any, bool) {
+		v, ok := lookup(rv, n)
+		if !ok {
+			return nil, false
+		}
+
+		if !v.IsValid() {
+			return nil, true
+		}
+
+		if !v.CanInterface() {
+			return nil, false
+		}
+		return v.Interface(), true
+	}
+}
+
+// Kinds of expression tokens.
+const (
+	tokEOF   = iota // End of expression.
+	tokNum          // Number literal.
+	tokStr          // String literal.
+	tokIdent        // Identifier.
+	tokField        // Field reference, e.g. ".Price".
+	tokOp           // Operator or punctuation.
+)
+
+// token is a token of expression.
+type token struct {
+	kind int    // Kind of token.
+	text string // Source of token.
+	pos  int    // Position in source.
+}
+
+// progParser holds state of expression parsing.
+type progParser struct {
+	src    string         // The source.
+	pos    int            // Position of next token.
+	tok    token          // Current token.
+	funcs  map[string]any // Callable functions.
+	fields []string       // Referenced fields.
+}
+
+// levels holds binary operators by precedence, lowest first.
+var levels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+// errorf returns syntax error at current token.
+func (p *progParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at %d: %s", errSyntax, p.tok.pos, fmt.Sprintf(format, args...))
+}
+
+// next reads next token.
+func (p *progParser) next() error {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+
+	var start = p.pos
+	p.tok = token{pos: start}
+	if p.pos >= len(p.src) {
+		p.tok.kind = tokEOF
+		return nil
+	}
+
+	var c = p.src[p.pos]
+	switch {
+	case c >= '0' && c <= '9':
+		for p.pos < len(p.src) && (isIdent(p.src[p.pos]) || p.src[p.pos] == '.' ||
+			((p.src[p.pos] == '+' || p.src[p.pos] == '-') && (p.src[p.pos-1] == 'e' || p.src[p.pos-1] == 'E'))) {
+			p.pos++
+		}
+		p.tok.kind = tokNum
+	case c == '"' || c == '\'' || c == '`':
+		p.pos++
+		for p.pos < len(p.src) && p.src[p.pos] != c {
+			if p.src[p.pos] == '\\' && c != '`' {
+				p.pos++
+			}
+			p.pos++
+		}
+
+		if p.pos >= len(p.src) {
+			return p.errorf("unterminated string")
+		}
+		p.pos++
+		p.tok.kind = tokStr
+	case c == '.' || isIdent(c):
+		p.pos++
+		for p.pos < len(p.src) && (isIdent(p.src[p.pos]) || (c == '.' && p.src[p.pos] == '.')) {
+			p.pos++
+		}
+
+		p.tok.kind = tokIdent
+		if c == '.' {
+			p.tok.kind = tokField
+		}
+	default:
+		p.tok.kind = tokOp
+		p.pos++
+		if p.pos < len(p.src) && contains([]string{"&&", "||", "==", "!=", "<=", ">="}, p.src[start:p.pos+1]) {
+			p.pos++
+		}
+	}
+
+	p.tok.text = p.src[start:p.pos]
+	return nil
+}
+
+// isIdent checks if 'c' is a character of identifier.
+func isIdent(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// binary parses binary operators of precedence 'level' and higher.
+func (p *progParser) binary(level int) (*node, error) {
+	if level == len(levels) {
+		return p.unary()
+	}
+
+	x, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for p.tok.kind == tokOp && contains(levels[level], p.tok.text) {
+		var op = p.tok.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		y, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		// Comparisons are not associative, "a < b < c" is an error.
+		if level == 2 && p.tok.kind == tokOp && contains(levels[level], p.tok.text) {
+			return nil, p.errorf("unexpected %s", p.tok.text)
+		}
+		x = &node{op: op, x: []*node{x, y}}
+	}
+	return x, nil
+}
+
+// contains checks if 's' contains 'v'.
+func contains(s []string, v string) bool {
+	for _, sv := range s {
+		if sv == v {
+			return true
+		}
+	}
+	return false
+}
+
+// unary parses unary operators and operands.
+func (p *progParser) unary() (*node, error) {
+	var tok = p.tok
+	if tok.kind == tokOp && (tok.text == "!" || tok.text == "-") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+
+		if tok.text == "-" {
+			return &node{op: "neg", x: []*node{x}}, nil
+		}
+		return &node{op: "!", x: []*node{x}}, nil
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	switch tok.kind {
+	case tokNum:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w at %d: invalid number %s", errSyntax, tok.pos, tok.text)
+		}
+		return &node{op: "lit", v: f}, nil
+	case tokStr:
+		var s = tok.text[1 : len(tok.text)-1]
+		if tok.text[0] == '"' {
+			var err error
+			if s, err = strconv.Unquote(tok.text); err != nil {
+				return nil, fmt.Errorf("%w at %d: invalid string %s", errSyntax, tok.pos, tok.text)
+			}
+		}
+		return &node{op: "lit", v: s}, nil
+	case tokField:
+		var path = strings.Split(tok.text[1:], ".")
+		for _, f := range path {
+			if f == "" {
+				return nil, fmt.Errorf("%w at %d: invalid field %s", errSyntax, tok.pos, tok.text)
+			}
+		}
+
+		if !contains(p.fields, path[0]) {
+			p.fields = append(p.fields, path[0])
+		}
+		return &node{op: "field", path: path}, nil
+	case tokIdent:
+		switch tok.text {
+		case "this":
+			return &node{op: "this"}, nil
+		case "true", "false":
+			return &node{op: "lit", v: tok.text == "true"}, nil
+		case "nil":
+			return &node{op: "lit"}, nil
+		}
+		return p.call(tok)
+	case tokOp:
+		if tok.text == "(" {
+			x, err := p.binary(0)
+			if err != nil {
+				return nil, err
+			}
+
+			if p.tok.text != ")" {
+				return nil, p.errorf("missing )")
+			}
+			return x, p.next()
+		}
+	case tokEOF:
+		return nil, fmt.Errorf("%w at %d: unexpected end of expression", errSyntax, tok.pos)
+	}
+	return nil, fmt.Errorf("%w at %d: unexpected %s", errSyntax, tok.pos, strconv.Quote(tok.text))
+}
+
+// call parses call of function named by identifier 'tok'.
+func (p *progParser) call(tok token) (*node, error) {
+	var n = &node{op: "call", v: tok.text}
+	if tok.text != "len" {
+		fn, ok := p.funcs[tok.text]
+		if !ok {
+			return nil, fmt.Errorf("%w at %d: unknown function %s", errSyntax, tok.pos, tok.text)
+		}
+		n.fn = reflect.ValueOf(fn)
+	}
+
+	if p.tok.text != "(" {
+		return nil, p.errorf("missing ( after %s", tok.text)
+	}
+
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	for p.tok.text != ")" {
+		x, err := p.binary(0)
+		if err != nil {
+			return nil, err
+		}
+		n.x = append(n.x, x)
+
+		if p.tok.text == "," {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		} else if p.tok.text != ")" {
+			return nil, p.errorf("missing ) of %s", tok.text)
+		}
+	}
+
+	var want = 1
+	if n.fn.IsValid() {
+		want = n.fn.Type().NumIn()
+	}
+
+	if len(n.x) != want {
+		return nil, fmt.Errorf("%w at %d: %s expects %d argument(s), got %d", errSyntax, tok.pos, tok.text, want, len(n.x))
+	}
+	return n, p.next()
+}
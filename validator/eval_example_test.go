@@ -0,0 +1,78 @@
+package validator_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type LineItem struct {
+	SKU      string   `json:"sku" v:"expr:len(this) == 8 && hasPrefix(this, \"SKU-\")"`
+	Price    float64  `json:"price" v:"gt:0"`
+	Discount float64  `json:"discount" v:"gte:0|expr:this <= .Price * 0.5"`
+	Tags     []string `json:"tags" v:"expr:len(this) <= 3"`
+}
+
+func ExampleValidator_RegisterFunc() {
+	v := validator.New()
+	if err := v.RegisterFunc("hasPrefix", strings.HasPrefix); err != nil {
+		panic(err)
+	}
+
+	var item = LineItem{SKU: "SKU-1", Price: 10, Discount: 6, Tags: []string{"a", "b"}}
+	result, err := v.ValidateStruct(item)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range result {
+		fmt.Println(r.F, r.E)
+	}
+
+	// Functions are registered to a single validator.
+	fmt.Println(validator.New().RegisterFunc("hasPrefix", strings.HasPrefix))
+	// Output:
+	// sku [must satisfy len(this) == 8 && hasPrefix(this, "SKU-")]
+	// discount [must satisfy this <= .Price * 0.5]
+	// <nil>
+}
+
+func ExampleParseProgram() {
+	p, err := validator.ParseProgram("this >= .Start && email(.Contact)")
+	if err != nil {
+		panic(err)
+	}
+
+	var fields = map[string]any{"Start": 10, "Contact": "jane@example.com"}
+	ok, err := p.Eval(12, func(n string) (any, bool) {
+		v, ok := fields[n]
+		return v, ok
+	})
+	fmt.Println(p.Fields(), ok, err)
+	// Output:
+	// [Start Contact] true <nil>
+}
+
+type Window struct {
+	Start int `json:"start" v:"gte:0"`
+}
+
+type Slot struct {
+	*Window `v:"-"`
+	End     int `json:"end" v:"expr:.Start == nil || this > .Start"`
+}
+
+func Example_exprEmbedded() {
+	// Fields promoted through nil embedded pointers are nil.
+	for _, s := range []Slot{{End: 5}, {Window: &Window{Start: 8}, End: 5}} {
+		res, err := validator.New().ValidateStruct(s)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(res)
+	}
+	// Output:
+	// []
+	// [{end [must satisfy .Start == nil || this > .Start]}]
+}
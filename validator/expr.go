@@ -0,0 +1,376 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Keywords of validator tag expressions.
+const (
+	OrKeyword  = "or"  // Keyword joining alternatives, e.g. "uuid or ulid".
+	NotKeyword = "not" // Keyword negating a rule, e.g. "not equal:0".
+)
+
+// Op is operator of validator tag expression node.
+type Op int
+
+// Operators of validator tag expression nodes.
+const (
+	OpRule  Op = iota // Single rule.
+	OpAnd             // All operands must pass, joined by [TagSep].
+	OpOr              // One of operands must pass, joined by [OrKeyword].
+	OpNot             // The operand must fail, prefixed by [NotKeyword].
+	OpAlias           // Expanded alias named by Tag, see [Validator.RegisterAlias].
+)
+
+// Expr is a node of validator tag expression parsed by [ParseExpr].
+type Expr struct {
+	Op  Op      // The operator.
+	Tag Tag     // The rule of [OpRule] node.
+	X   []*Expr // Operands of [OpAnd], [OpOr] and [OpNot] nodes.
+	s   string  // Source of the node.
+}
+
+// Indicates that a validator tag expression is malformed.
+var errInvalidExpr = errors.New("invalid tag expression")
+
+// String returns source of the expression.
+func (e *Expr) String() string {
+	return e.s
+}
+
+// Tags returns rules of the expression if it is a plain list of rules
+// joined by [TagSep], as parsed by [ParseTag]. It returns false if the
+// expression contains [OrKeyword], [NotKeyword] or parentheses.
+func (e *Expr) Tags() ([]Tag, bool) {
+	switch e.Op {
+	case OpRule:
+		return []Tag{e.Tag}, true
+	case OpAnd:
+		var t = make([]Tag, 0, len(e.X))
+		for _, x := range e.X {
+			if x.Op != OpRule {
+				return nil, false
+			}
+			t = append(t, x.Tag)
+		}
+		return t, true
+	default:
+		return nil, false
+	}
+}
+
+// Walk calls 'fn' for rule of every [OpRule] node of the expression.
+func (e *Expr) Walk(fn func(Tag)) {
+	if e.Op == OpRule {
+		fn(e.Tag)
+		return
+	}
+
+	for _, x := range e.X {
+		x.Walk(fn)
+	}
+}
+
+// ParseExpr parse validator tag 'v' into expression. Rules are joined by
+// [TagSep] like [ParseTag] does, rules or groups of rules can be joined by
+// [OrKeyword], negated by [NotKeyword] and grouped by parentheses, e.g.
+// "(uuid or ulid)|lowercase" or "not equal:0". [NotKeyword] binds tighter
+// than [TagSep] which binds tighter than [OrKeyword], so "a|b or c" means
+// "(a|b) or c". It returns an error if the expression is malformed, a tag
+// is not supported or parameters are invalid.
+func ParseExpr(v string) (*Expr, error) {
+	return ParseExprFunc(v, parseRule)
+}
+
+// ParseExprFunc parse validator tag 'v' like [ParseExpr], but parses each
+// rule with 'rule', e.g. to accept rules unknown to the package.
+func ParseExprFunc(v string, rule func(string) (Tag, error)) (*Expr, error) {
+	var p = exprParser{s: v, rule: rule}
+
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.space(); p.pos < len(p.s) {
+		return nil, fmt.Errorf("%w: unexpected %q at %d", errInvalidExpr, p.s[p.pos], p.pos)
+	}
+	return e, nil
+}
+
+// exprParser holds state of validator tag expression parsing.
+type exprParser struct {
+	s     string                    // The source.
+	pos   int                       // Current position.
+	depth int                       // Depth of parentheses.
+	rule  func(string) (Tag, error) // Rule parser.
+}
+
+// or parses alternatives joined by [OrKeyword].
+func (p *exprParser) or() (*Expr, error) {
+	var start = p.pos
+
+	x, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	var e = []*Expr{x}
+	for p.space(); p.keyword(OrKeyword); p.space() {
+		x, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		e = append(e, x)
+	}
+	return p.node(OpOr, e, start), nil
+}
+
+// and parses operands joined by [TagSep].
+func (p *exprParser) and() (*Expr, error) {
+	var start = p.pos
+
+	x, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	var e = []*Expr{x}
+	for p.space(); strings.HasPrefix(p.s[p.pos:], TagSep); p.space() {
+		p.pos += len(TagSep)
+
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		e = append(e, x)
+	}
+	return p.node(OpAnd, e, start), nil
+}
+
+// unary parses negation, group or single rule.
+func (p *exprParser) unary() (*Expr, error) {
+	p.space()
+
+	var start = p.pos
+	switch {
+	case p.keyword(NotKeyword):
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Expr{Op: OpNot, X: []*Expr{x}, s: p.source(start)}, nil
+	case strings.HasPrefix(p.s[p.pos:], "("):
+		p.pos++
+		p.depth++
+
+		x, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.space(); !strings.HasPrefix(p.s[p.pos:], ")") {
+			return nil, fmt.Errorf("%w: missing ) at %d", errInvalidExpr, p.pos)
+		}
+
+		p.pos++
+		p.depth--
+		return x, nil
+	}
+
+	var end = p.pos
+	for end < len(p.s) && !p.stop(end) {
+		end++
+	}
+
+	// Parameter of raw rules extends to the end of the group, so it may
+	// contain separators and keywords.
+	var n, _, _ = strings.Cut(p.s[p.pos:end], PairSep)
+	if R[n].Raw {
+		end = p.raw(p.pos)
+	}
+
+	var src = strings.TrimRightFunc(p.s[p.pos:end], unicode.IsSpace)
+	if src == "" {
+		return nil, fmt.Errorf("%w: missing rule at %d", errInvalidExpr, p.pos)
+	}
+
+	t, err := p.rule(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p.pos = end
+	return &Expr{Op: OpRule, Tag: t, s: src}, nil
+}
+
+// stop checks if rule ends at position 'i': at [TagSep], at closing
+// parenthesis of a group or at [OrKeyword] preceded by space.
+func (p *exprParser) stop(i int) bool {
+	var rest = p.s[i:]
+	switch {
+	case strings.HasPrefix(rest, TagSep):
+		return true
+	case p.depth > 0 && strings.HasPrefix(rest, ")"):
+		return true
+	case unicode.IsSpace(rune(rest[0])):
+		var q = exprParser{s: p.s, pos: i}
+		q.space()
+		return q.keyword(OrKeyword)
+	default:
+		return false
+	}
+}
+
+// raw returns end of raw rule starting at position 'i': end of the source
+// or closing parenthesis of the group, skipping parentheses and quoted
+// strings of the rule.
+func (p *exprParser) raw(i int) int {
+	var depth int
+	for ; i < len(p.s); i++ {
+		switch c := p.s[i]; c {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 && p.depth > 0 {
+				return i
+			}
+			depth--
+		case '"', '\'', '`':
+			for i++; i < len(p.s) && p.s[i] != c; i++ {
+				if p.s[i] == '\\' && c != '`' {
+					i++
+				}
+			}
+		}
+	}
+	return len(p.s)
+}
+
+// keyword consumes keyword 'k' if it is followed by space or parenthesis.
+func (p *exprParser) keyword(k string) bool {
+	var rest = p.s[p.pos:]
+	if !strings.HasPrefix(rest, k) || len(rest) == len(k) {
+		return false
+	}
+
+	if c := rune(rest[len(k)]); !unicode.IsSpace(c) && c != '(' {
+		return false
+	}
+
+	p.pos += len(k)
+	return true
+}
+
+// space skips spaces.
+func (p *exprParser) space() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+}
+
+// source returns source from position 'start' to current position.
+func (p *exprParser) source(start int) string {
+	return strings.TrimSpace(p.s[start:p.pos])
+}
+
+// node returns node of operator 'op' with operands 'x', or the operand
+// itself if there is only one. Operands of the same operator, e.g. from
+// grouped alternatives, are merged into the node.
+func (p *exprParser) node(op Op, x []*Expr, start int) *Expr {
+	if len(x) == 1 {
+		return x[0]
+	}
+
+	var e = &Expr{Op: op, s: p.source(start)}
+	for _, xe := range x {
+		if xe.Op == op {
+			e.X = append(e.X, xe.X...)
+		} else {
+			e.X = append(e.X, xe)
+		}
+	}
+	return e
+}
+
+// validateExpr validate 'v' against expression 'e', resolving sibling
+// fields of [ExprRule] by 's', values are converted by rules like
+// [Validator.validateField] does with 'lax'. Plain lists of rules are validated like
+// [Validator.ValidateField], messages of alternatives and negations are
+// created by [Or] and [Not], messages of aliases registered with message
+// are replaced by it. It returns an error if a rule fails to validate the
+// value.
+func (r *Validator) validateExpr(v any, e *Expr, s Scope, lax bool) ([]string, error) {
+	if t, ok := e.Tags(); ok {
+		return r.validateField(v, t, s, lax)
+	}
+
+	switch e.Op {
+	case OpAnd:
+		var m = make([]string, 0)
+		for _, x := range e.X {
+			xm, err := r.validateExpr(v, x, s, lax)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, xm...)
+		}
+		return m, nil
+	case OpOr:
+		var alt = make([][]string, 0, len(e.X))
+		for _, x := range e.X {
+			xm, err := r.validateExpr(v, x, s, lax)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(xm) == 0 {
+				return nil, nil
+			}
+			alt = append(alt, xm)
+		}
+		return Or(alt...), nil
+	case OpAlias:
+		xm, err := r.validateExpr(v, e.X[0], s, lax)
+		if err != nil || len(xm) == 0 {
+			return xm, err
+		}
+
+		if msg := r.aliases[e.Tag.N].msg; msg != "" {
+			return []string{msg}, nil
+		}
+		return xm, nil
+	default:
+		xm, err := r.validateExpr(v, e.X[0], s, lax)
+		if err != nil {
+			return nil, err
+		}
+		return Not(e.X[0].String(), xm), nil
+	}
+}
+
+// Or returns message explaining why alternatives of [OpOr] expression
+// failed, given messages 'alt' of each alternative. It returns nil if an
+// alternative has no messages.
+func Or(alt ...[]string) []string {
+	var s = make([]string, 0, len(alt))
+	for _, m := range alt {
+		if len(m) == 0 {
+			return nil
+		}
+		s = append(s, strings.Join(m, ", "))
+	}
+	return []string{fmt.Sprintf(E[OrKeyword], strings.Join(s, "; "))}
+}
+
+// Not returns message of [OpNot] expression negating rules 'src', given
+// messages 'm' of the rules. It returns nil if 'm' is not empty.
+func Not(src string, m []string) []string {
+	if len(m) > 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf(E[NotKeyword], src)}
+}
@@ -0,0 +1,42 @@
+package validator_test
+
+import (
+	"fmt"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Transfer struct {
+	ID     string `json:"id" v:"uuid or ulid"`
+	Code   string `json:"code" v:"(alpha|uppercase) or not ascii"`
+	Amount int    `json:"amount" v:"not equal:0|lt:1000"`
+}
+
+func Example_expr() {
+	var t = Transfer{ID: "42", Code: "usd", Amount: 0}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(t)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range result {
+		fmt.Println(r.F, r.E)
+	}
+	// Output:
+	// id [none of the alternatives matched: invalid UUID; invalid ULID]
+	// code [none of the alternatives matched: must be uppercase characters; must not match ascii]
+	// amount [must not match equal:0]
+}
+
+func ExampleParseExpr() {
+	e, err := validator.ParseExpr("alpha|lowercase or not (uuid or ulid)")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(e.Op == validator.OpOr, e.X[0], e.X[1])
+	// Output:
+	// true alpha|lowercase not (uuid or ulid)
+}
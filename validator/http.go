@@ -0,0 +1,242 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/n4x2/zoo/to"
+)
+
+// Default values for request binding.
+const (
+	MaxMemory          = 32 << 20                   // Maximum memory used to parse multipart form.
+	ProblemContentType = "application/problem+json" // Content type of problem details.
+)
+
+// Indicates that the bind target is not a non-nil pointer to struct.
+var errInvalidTarget = errors.New("target must be a non-nil pointer to struct")
+
+type (
+	// BindError an error type for request that cannot be decoded. It
+	// holds HTTP status code that should be returned to the client.
+	BindError struct {
+		Status int   // HTTP status code.
+		Err    error // The cause.
+	}
+
+	// ValidationError an error type returned by [Validator.Bind] when
+	// the decoded value fails validation.
+	ValidationError struct {
+		Results []Result // Validation results.
+	}
+
+	// Problem represents problem details for HTTP APIs as defined in
+	// RFC 9457, extended with validation errors.
+	Problem struct {
+		Type     string   `json:"type,omitempty"`     // URI reference of the problem type.
+		Title    string   `json:"title"`              // Short summary of the problem type.
+		Status   int      `json:"status"`             // HTTP status code.
+		Detail   string   `json:"detail,omitempty"`   // Explanation of the occurrence.
+		Instance string   `json:"instance,omitempty"` // URI reference of the occurrence.
+		Errors   []Result `json:"errors,omitempty"`   // Validation errors.
+	}
+)
+
+// Error an error for the BindError type.
+func (e *BindError) Error() string {
+	return fmt.Sprintf("validator: bind request: %v", e.Err)
+}
+
+// Unwrap returns the cause of the BindError.
+func (e *BindError) Unwrap() error {
+	return e.Err
+}
+
+// Error an error for the ValidationError type.
+func (e *ValidationError) Error() string {
+	var s = make([]string, len(e.Results))
+	for i, r := range e.Results {
+		s[i] = r.F + ": " + strings.Join(r.E, ", ")
+	}
+	return "validator: " + strings.Join(s, "; ")
+}
+
+// values convert 'v' into map accepted by [to.Struct], fields with single
+// value are stored as string and the others as slice of strings.
+func values(v url.Values) map[string]any {
+	var m = make(map[string]any, len(v))
+	for k, s := range v {
+		if len(s) == 1 {
+			m[k] = s[0]
+			continue
+		}
+		m[k] = s
+	}
+	return m
+}
+
+// decodeValues decode 'v' into 'dst' using [JSONTag] to map field names.
+func decodeValues(dst any, v url.Values) error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	err := to.StructWithTag(dst, values(v), JSONTag)
+	if err != nil {
+		return &BindError{Status: http.StatusBadRequest, Err: err}
+	}
+	return nil
+}
+
+// Bind decode query parameters and request body into struct pointed by
+// 'dst', then validate it. Body is decoded according to its content type:
+// JSON, URL-encoded form or multipart form, field names are mapped by
+// [JSONTag] and body values take precedence over query parameters.
+// Defaults of [DefaultTag] and modifiers of [ModTag] are applied to 'dst'
+// before validation, see [Validator.Modify]. It returns [BindError] if the
+// request cannot be decoded, [ValidationError] if validation fails, or
+// other error returned by [Validator.ValidateStruct].
+func (r *Validator) Bind(req *http.Request, dst any) error {
+	var rv = reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validator: %w", errInvalidTarget)
+	}
+
+	if err := decodeValues(dst, req.URL.Query()); err != nil {
+		return err
+	}
+
+	if ct := req.Header.Get("Content-Type"); ct != "" && req.Body != nil && req.Body != http.NoBody {
+		mt, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			return &BindError{Status: http.StatusUnsupportedMediaType, Err: err}
+		}
+
+		switch {
+		case mt == "application/json" || strings.HasSuffix(mt, "+json"):
+			err := json.NewDecoder(req.Body).Decode(dst)
+			if err != nil {
+				var mbe *http.MaxBytesError
+				if errors.As(err, &mbe) {
+					return &BindError{Status: http.StatusRequestEntityTooLarge, Err: err}
+				}
+				return &BindError{Status: http.StatusBadRequest, Err: err}
+			}
+		case mt == "application/x-www-form-urlencoded":
+			if err := req.ParseForm(); err != nil {
+				return &BindError{Status: http.StatusBadRequest, Err: err}
+			}
+
+			if err := decodeValues(dst, req.PostForm); err != nil {
+				return err
+			}
+		case mt == "multipart/form-data":
+			if err := req.ParseMultipartForm(MaxMemory); err != nil {
+				return &BindError{Status: http.StatusBadRequest, Err: err}
+			}
+
+			var m = values(req.MultipartForm.Value)
+			for k, fh := range req.MultipartForm.File {
+				m[k] = fh[0]
+				if len(fh) > 1 {
+					m[k] = fh
+				}
+			}
+
+			if err := to.StructWithTag(dst, m, JSONTag); err != nil {
+				return &BindError{Status: http.StatusBadRequest, Err: err}
+			}
+		default:
+			return &BindError{
+				Status: http.StatusUnsupportedMediaType,
+				Err:    fmt.Errorf("unsupported content type %s", mt),
+			}
+		}
+	}
+
+	res, err := r.ValidateStruct(dst)
+	if err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return &ValidationError{Results: res}
+	}
+	return nil
+}
+
+// Bind decode and validate request into 'dst' using default validator,
+// see [Validator.Bind].
+func Bind(req *http.Request, dst any) error {
+	return std.Bind(req, dst)
+}
+
+// NewProblem creates [Problem] describing 'err' returned by [Validator.Bind].
+// Validation failures are reported as 422 Unprocessable Entity with the
+// validation results, bind errors use their status code and other errors
+// are reported as 500 Internal Server Error without exposing the detail.
+func NewProblem(err error) Problem {
+	var (
+		be *BindError
+		ve *ValidationError
+	)
+
+	switch {
+	case errors.As(err, &ve):
+		return Problem{
+			Title:  "Validation failed",
+			Status: http.StatusUnprocessableEntity,
+			Detail: "The request contains invalid fields.",
+			Errors: ve.Results,
+		}
+	case errors.As(err, &be):
+		return Problem{
+			Title:  http.StatusText(be.Status),
+			Status: be.Status,
+			Detail: be.Err.Error(),
+		}
+	default:
+		return Problem{
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+		}
+	}
+}
+
+// WriteProblem writes 'err' returned by [Validator.Bind] as RFC 9457
+// problem details JSON response, see [NewProblem].
+func WriteProblem(w http.ResponseWriter, req *http.Request, err error) {
+	var p = NewProblem(err)
+	if req != nil {
+		p.Instance = req.URL.Path
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// Handler returns [http.Handler] that binds every request into a new value
+// of T using validator 'v', or the default validator if nil, and calls
+// 'fn' with it. Requests that fail to bind are answered with
+// [WriteProblem].
+func Handler[T any](v *Validator, fn func(http.ResponseWriter, *http.Request, T)) http.Handler {
+	if v == nil {
+		v = std
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var dst T
+		if err := v.Bind(req, &dst); err != nil {
+			WriteProblem(w, req, err)
+			return
+		}
+		fn(w, req, dst)
+	})
+}
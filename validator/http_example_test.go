@@ -0,0 +1,87 @@
+package validator_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Signup struct {
+	Email string `json:"email" v:"email"`
+	Name  string `json:"name" v:"lowercase"`
+	Age   int    `json:"age" v:"gte:18"`
+	Ref   string `json:"ref" v:"-|lowercase"`
+}
+
+func ExampleValidator_Bind() {
+	var body = strings.NewReader(`{"email": "jane@example.com", "name": "jane", "age": 21}`)
+	req := httptest.NewRequest(http.MethodPost, "/signup?ref=campaign1", body)
+	req.Header.Set("Content-Type", "application/json")
+
+	var s Signup
+	err := validator.New().Bind(req, &s)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", s)
+
+	var form = strings.NewReader("email=jane&name=Jane&age=16")
+	req = httptest.NewRequest(http.MethodPost, "/signup", form)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	err = validator.New().Bind(req, &s)
+	fmt.Println(err)
+	// Output:
+	// {Email:jane@example.com Name:jane Age:21 Ref:campaign1}
+	// validator: email: invalid email address; name: must be lowercase characters; age: must be greater than or equal to 18
+}
+
+type Settings struct {
+	Name string `json:"name" v:"alpha" mod:"trim|lower"`
+	Lang string `json:"lang" v:"enum:en,id" default:"en"`
+}
+
+func ExampleValidator_Bind_modify() {
+	req := httptest.NewRequest(http.MethodPost, "/profile", strings.NewReader(`{"name": "  Jane "}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Defaults and modifiers are applied before validation.
+	var p Settings
+	if err := validator.Bind(req, &p); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", p)
+	// Output:
+	// {Name:jane Lang:en}
+}
+
+func ExampleHandler() {
+	h := validator.Handler(nil, func(w http.ResponseWriter, r *http.Request, s Signup) {
+		fmt.Fprintf(w, "welcome %s", s.Name)
+	})
+
+	for _, body := range []string{
+		`{"email": "jane@example.com", "name": "jane", "age": 21}`,
+		`{"email": "jane@example.com", "name": "jane", "age": 17}`,
+		`{"email": `,
+	} {
+		req := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+
+		b, _ := io.ReadAll(rec.Body)
+		fmt.Println(rec.Code, rec.Header().Get("Content-Type"), strings.TrimSpace(string(b)))
+	}
+	// Output:
+	// 200 text/plain; charset=utf-8 welcome jane
+	// 422 application/problem+json {"title":"Validation failed","status":422,"detail":"The request contains invalid fields.","instance":"/signup","errors":[{"field":"age","messages":["must be greater than or equal to 18"]}]}
+	// 400 application/problem+json {"title":"Bad Request","status":400,"detail":"unexpected EOF","instance":"/signup"}
+}
@@ -0,0 +1,368 @@
+package validator
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Indicates that the JSON document is not an object.
+var errNotObject = errors.New("JSON document is not an object")
+
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// ValidateJSON validate JSON object read from 'src' against rules of 'v',
+// which is either a [RuleSet] or a struct, without decoding the document
+// into the struct. Values are decoded into their field type one by one,
+// unknown keys are skipped, missing keys are validated as zero value of
+// their field type, and null values are absent like nil pointers of
+// [Validator.ValidateStruct], see [RequiredRule]. Nested objects and
+// arrays of objects are validated against rules of nested structs.
+// Expressions of [ExprRule] resolve sibling fields from scalar members of
+// the same object, so members referring to siblings are validated once
+// the object is read. It will returns slices of [Result] with field name
+// set to JSON pointer of the invalid value, e.g. "/items/0/name", in
+// document order otherwise. It returns an error if the document is
+// malformed or not an object, or if validation fails as in
+// [Validator.ValidateField].
+func (r *Validator) ValidateJSON(src io.Reader, v any) ([]Result, error) {
+	rs, ok := v.(*RuleSet)
+	if !ok {
+		var err error
+		rs, err = r.Compile(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var dec = json.NewDecoder(src)
+	dec.UseNumber()
+
+	var res = make([]Result, 0)
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("validator: %w", err)
+	}
+
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("validator: %w", errNotObject)
+	}
+
+	if err := r.validateObject(dec, rs, "", &res); err != nil {
+		return nil, fmt.Errorf("validator: %w", err)
+	}
+	return res, nil
+}
+
+// ValidateJSONBytes validate JSON object 'b' against rules of 'v', see
+// [Validator.ValidateJSON].
+func (r *Validator) ValidateJSONBytes(b []byte, v any) ([]Result, error) {
+	return r.ValidateJSON(bytes.NewReader(b), v)
+}
+
+// validateObject validate members of JSON object read from 'dec' after its
+// opening delimiter against 'rs', appending results into 'res'. Scalar
+// members are set into a value of the struct type resolving sibling fields
+// of expressions, members referring to siblings are validated after the
+// object is read.
+func (r *Validator) validateObject(dec *json.Decoder, rs *RuleSet, path string, res *[]Result) error {
+	var seen = make([]bool, len(rs.fields))
+	var sv = reflect.New(rs.t).Elem()
+	var s = structScope(sv)
+	var late []member
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var k = tok.(string)
+		i, ok := rs.field(k)
+		if !ok {
+			if err := skip(dec, 0); err != nil {
+				return err
+			}
+			continue
+		}
+
+		seen[i] = true
+		var f = &rs.fields[i]
+		v, ok, err := r.validateMember(dec, f, pointer(path, k), res)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			// Nested values and type mismatches are already
+			// validated.
+			continue
+		}
+
+		set(sv.Field(i), v)
+		if f.siblings() {
+			late = append(late, member{v: v, e: f.expr, path: pointer(path, k)})
+			continue
+		}
+
+		if err := r.validateValue(v, f.expr, s, pointer(path, k), res); err != nil {
+			return err
+		}
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	for _, m := range late {
+		if err := r.validateValue(m.v, m.e, s, m.path, res); err != nil {
+			return err
+		}
+	}
+
+	for i, f := range rs.fields {
+		if seen[i] {
+			continue
+		}
+
+		if err := r.validateValue(reflect.Zero(f.t).Interface(), f.expr, s, pointer(path, f.name), res); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// member holds scalar member of JSON object validated after the object is
+// read.
+type member struct {
+	v    any    // The decoded value.
+	e    *Expr  // Validation rules.
+	path string // JSON pointer of the value.
+}
+
+// validateMember read next JSON value from 'dec' for field 'f', appending
+// results into 'res'. Objects and arrays are only validated against
+// nested rules, other values are converted into field type and returned
+// with true to be validated by the caller.
+func (r *Validator) validateMember(dec *json.Decoder, f *fieldRule, path string, res *[]Result) (any, bool, error) {
+	var k = f.t.Kind()
+	if k == reflect.Pointer {
+		k = f.t.Elem().Kind()
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		if f.nested != nil && k == reflect.Struct {
+			return nil, false, r.validateObject(dec, f.nested, path, res)
+		}
+		return nil, false, skip(dec, 1)
+	case json.Delim('['):
+		if f.nested == nil || (k != reflect.Slice && k != reflect.Array) {
+			return nil, false, skip(dec, 1)
+		}
+
+		for i := 0; dec.More(); i++ {
+			var ep = pointer(path, strconv.Itoa(i))
+
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, false, err
+			}
+
+			switch tok {
+			case json.Delim('{'):
+				err = r.validateObject(dec, f.nested, ep, res)
+			case json.Delim('['):
+				*res = append(*res, Result{F: ep, E: []string{"invalid type, expected object"}})
+				err = skip(dec, 1)
+			default:
+				*res = append(*res, Result{F: ep, E: []string{"invalid type, expected object"}})
+			}
+
+			if err != nil {
+				return nil, false, err
+			}
+		}
+
+		_, err := dec.Token()
+		return nil, false, err
+	}
+
+	v, ok := scalar(tok, f.t)
+	if !ok {
+		*res = append(*res, Result{F: path, E: []string{"invalid type, expected " + jsonType(f.t)}})
+		return nil, false, nil
+	}
+	return v, true, nil
+}
+
+// validateValue validate 'v' against 'e' with sibling fields resolved by
+// 's', appending results into 'res'. Values without rules are not
+// validated.
+func (r *Validator) validateValue(v any, e *Expr, s Scope, path string, res *[]Result) error {
+	if e == nil {
+		return nil
+	}
+
+	m, err := r.validateExpr(v, e, s, false)
+	if err != nil {
+		return fmt.Errorf("field %s: %w", path, err)
+	}
+
+	if len(m) > 0 {
+		*res = append(*res, Result{F: path, E: m})
+	}
+	return nil
+}
+
+// set sets struct field 'fv' into value 'v' converted by [scalar],
+// allocating pointers. Nil and values not assignable to the field are
+// skipped.
+func set(fv reflect.Value, v any) {
+	if v == nil || !fv.CanSet() {
+		return
+	}
+
+	for fv.Kind() == reflect.Pointer {
+		fv.Set(reflect.New(fv.Type().Elem()))
+		fv = fv.Elem()
+	}
+
+	if rv := reflect.ValueOf(v); rv.Type().AssignableTo(fv.Type()) {
+		fv.Set(rv)
+	}
+}
+
+// scalar convert JSON scalar token 'tok' into value of type 't', pointer
+// types are converted into their element type and null into nil. It
+// returns false if the token is not assignable to the type.
+func scalar(tok json.Token, t reflect.Type) (any, bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var pv = reflect.New(t)
+	switch tok := tok.(type) {
+	case nil:
+		return nil, true
+	case string:
+		switch {
+		case t.Kind() == reflect.String:
+			pv.Elem().SetString(tok)
+		case pv.Type().Implements(textUnmarshalerType):
+			if pv.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(tok)) != nil {
+				return nil, false
+			}
+		case t.Kind() == reflect.Interface:
+			return tok, true
+		default:
+			return nil, false
+		}
+	case json.Number:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			i, err := strconv.ParseInt(tok.String(), 10, t.Bits())
+			if err != nil {
+				return nil, false
+			}
+			pv.Elem().SetInt(i)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			i, err := strconv.ParseUint(tok.String(), 10, t.Bits())
+			if err != nil {
+				return nil, false
+			}
+			pv.Elem().SetUint(i)
+		case reflect.Float32, reflect.Float64:
+			f, err := strconv.ParseFloat(tok.String(), t.Bits())
+			if err != nil {
+				return nil, false
+			}
+			pv.Elem().SetFloat(f)
+		case reflect.Interface:
+			f, err := tok.Float64()
+			return f, err == nil
+		default:
+			return nil, false
+		}
+	case bool:
+		switch t.Kind() {
+		case reflect.Bool:
+			pv.Elem().SetBool(tok)
+		case reflect.Interface:
+			return tok, true
+		default:
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	return pv.Elem().Interface(), true
+}
+
+// jsonType returns name of JSON type that can be decoded into type 't'.
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+			return "string"
+		}
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// skip consumes JSON tokens from 'dec' until 'depth' opened objects and
+// arrays are closed. With zero depth it consumes the next value.
+func skip(dec *json.Decoder, depth int) error {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+// pointer appends reference token 'k' to JSON pointer 'path', escaping it
+// as defined in RFC 6901.
+func pointer(path, k string) string {
+	k = strings.ReplaceAll(k, "~", "~0")
+	k = strings.ReplaceAll(k, "/", "~1")
+	return path + "/" + k
+}
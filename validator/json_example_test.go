@@ -0,0 +1,110 @@
+package validator_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Item struct {
+	Name string `json:"name" v:"alpha"`
+	Qty  int    `json:"qty" v:"gt:0"`
+}
+
+type Cart struct {
+	Owner string `json:"owner" v:"email"`
+	Items []Item `json:"items" v:"-"`
+}
+
+func ExampleValidator_ValidateJSON() {
+	var doc = `{
+		"owner": "jane@example.com",
+		"items": [
+			{"name": "apple", "qty": 2},
+			{"name": "b4n4n4", "qty": 0}
+		]
+	}`
+
+	v := validator.New()
+	rs, err := v.Compile(Cart{})
+	if err != nil {
+		panic(err)
+	}
+
+	res, err := v.ValidateJSON(strings.NewReader(doc), rs)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range res {
+		fmt.Println(r.F, r.E)
+	}
+	// Output:
+	// /items/1/name [must be alphabetic characters]
+	// /items/1/qty [must be greater than 0]
+}
+
+func ExampleValidator_ValidateJSONBytes() {
+	// Null values are absent like nil pointers, so only rules such as
+	// required validate them.
+	for _, doc := range []string{
+		`{"owner": 42}`,
+		`{"owner": null, "items": [{"name": null, "qty": 1}]}`,
+	} {
+		res, err := validator.New().ValidateJSONBytes([]byte(doc), Cart{})
+		if err != nil {
+			panic(err)
+		}
+
+		for _, r := range res {
+			fmt.Println(r.F, r.E)
+		}
+	}
+	// Output:
+	// /owner [invalid type, expected string]
+}
+
+func ExampleValidator_ValidateJSON_nested() {
+	// Members after nested objects, arrays and type mismatches are
+	// validated as well.
+	var doc = `{
+		"items": [{"qty": "one", "name": "b4n4n4"}],
+		"owner": "jane"
+	}`
+
+	res, err := validator.New().ValidateJSON(strings.NewReader(doc), Cart{})
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range res {
+		fmt.Println(r.F, r.E)
+	}
+	// Output:
+	// /items/0/qty [invalid type, expected integer]
+	// /items/0/name [must be alphabetic characters]
+	// /owner [invalid email address]
+}
+
+type Offer struct {
+	Discount float64 `json:"discount" v:"expr:this <= .price"`
+	Price    float64 `json:"price" v:"gt:0"`
+}
+
+func ExampleValidator_ValidateJSON_expr() {
+	// Siblings are resolved from the same object, wherever they appear.
+	for _, doc := range []string{
+		`{"discount": 12, "price": 10}`,
+		`{"discount": 5, "price": 10}`,
+	} {
+		res, err := validator.New().ValidateJSON(strings.NewReader(doc), Offer{})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(res)
+	}
+	// Output:
+	// [{/discount [must satisfy this <= .price]}]
+	// []
+}
@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	unorm "golang.org/x/text/unicode/norm"
+)
+
+// ModTag is tag of modifiers applied to string fields before validation,
+// e.g. `mod:"trim|lower"`, see [Validator.Modify].
+const ModTag = "mod"
+
+// Transform returns string 'v' modified with parameters 'p' of modifier.
+type Transform func(v string, p []string) string
+
+// Transforms holds modifiers of [ModTag] by name. Validators copy them
+// when created by [New], others are added to a single validator by
+// [Validator.RegisterTransform].
+var Transforms = map[string]Transform{
+	"collapse": func(v string, _ []string) string { return strings.Join(strings.Fields(v), " ") },
+	"default":  defaultString,
+	"lower":    func(v string, _ []string) string { return strings.ToLower(v) },
+	"nfc":      func(v string, _ []string) string { return unorm.NFC.String(v) },
+	"strip":    func(v string, _ []string) string { return strings.Map(printable, v) },
+	"title":    func(v string, _ []string) string { return cases.Title(language.Und).String(v) },
+	"trim":     func(v string, _ []string) string { return strings.TrimSpace(v) },
+	"upper":    func(v string, _ []string) string { return strings.ToUpper(v) },
+}
+
+// Indicates that a modifier of [ModTag] is not found.
+var errTransformUnsupported = errors.New("unsupported modifier")
+
+// modifier is a modifier of [ModTag] with its parameters.
+type modifier struct {
+	n  string    // The modifier name.
+	fn Transform // The transform.
+	p  []string  // The parameters.
+}
+
+// errTransformPanic an error type for cases where a modifier panics.
+type errTransformPanic struct {
+	n string // The modifier name.
+	v any    // The recovered value.
+}
+
+// Error an error for the errTransformPanic type.
+func (e *errTransformPanic) Error() string {
+	return fmt.Sprintf("%s: modifier panicked: %v", e.n, e.v)
+}
+
+// Unwrap returns the recovered value if it is an error.
+func (e *errTransformPanic) Unwrap() error {
+	err, _ := e.v.(error)
+	return err
+}
+
+// RegisterTransform registers modifier 'fn' of [ModTag] by name 'n'. It
+// returns an error if modifier name already exists.
+func (r *Validator) RegisterTransform(n string, fn Transform) error {
+	if _, exists := r.transforms[n]; exists {
+		return errors.New("modifier " + n + " already exists")
+	}
+
+	r.transforms[n] = fn
+	return nil
+}
+
+// Modify assigns values of [DefaultTag] to zero fields and then applies
+// modifiers of [ModTag] in place to fields of struct pointed by 'v' and of
+// its nested structs. Modifiers are applied in order to strings, pointers
+// to strings and their slices and arrays, e.g.
+// `mod:"trim|collapse|default:n/a"`. Fields having only [ModTag] or
+// [DefaultTag] are not validated by [Validator.ValidateStruct]. It returns
+// an error if 'v' is not a pointer to struct, failed to compile rules of
+// the struct or a modifier panics, fields are left partially modified.
+func (r *Validator) Modify(v any) error {
+	var rv = reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validator: %w", errInvalidInput)
+	}
+
+	rs, err := r.plan(rv.Elem().Type())
+	if err != nil {
+		return err
+	}
+
+	if err := r.modify(rv.Elem(), rs); err != nil {
+		return fmt.Errorf("validator: %w", err)
+	}
+	return nil
+}
+
+// modify applies modifiers of rule set 'rs' to addressable struct 'rv'.
+// It returns an error if a modifier panics.
+func (r *Validator) modify(rv reflect.Value, rs *RuleSet) error {
+	for i, f := range rs.fields {
+		if f.def != nil {
+			assignDefault(rv.Field(i), *f.def)
+		}
+
+		var err error
+		if len(f.mods) > 0 {
+			each(rv.Field(i), func(fv reflect.Value) {
+				if err != nil {
+					return
+				}
+
+				var s string
+				if s, err = transform(fv.String(), f.mods); err == nil {
+					fv.SetString(s)
+				}
+			})
+		}
+
+		if f.nested != nil {
+			each(rv.Field(i), func(fv reflect.Value) {
+				if err == nil {
+					err = r.modify(fv, f.nested)
+				}
+			})
+		}
+
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.name, err)
+		}
+	}
+	return nil
+}
+
+// transform applies modifiers 'mods' in order to 'v'. It returns an error
+// if a modifier panics.
+func transform(v string, mods []modifier) (s string, err error) {
+	var n string
+	defer func() {
+		if p := recover(); p != nil {
+			s, err = "", &errTransformPanic{n: n, v: p}
+		}
+	}()
+
+	for _, m := range mods {
+		n = m.n
+		v = m.fn(v, m.p)
+	}
+	return v, nil
+}
+
+// mods parses modifiers of [ModTag] 'tag', modifiers are separated by
+// [TagSep] and parameters like rules of validator tag. It returns an
+// error if a modifier is not found.
+func (r *Validator) mods(tag string) ([]modifier, error) {
+	var m []modifier
+	for _, s := range strings.Split(tag, TagSep) {
+		var n, p, hasp = strings.Cut(strings.TrimSpace(s), PairSep)
+
+		fn, ok := r.transforms[n]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errTransformUnsupported, n)
+		}
+
+		var mod = modifier{n: n, fn: fn}
+		if hasp {
+			mod.p = strings.Split(p, ParamSep)
+		}
+		m = append(m, mod)
+	}
+	return m, nil
+}
+
+// each calls 'fn' with 'v', values pointed by non-nil pointers and
+// elements of slices and arrays.
+func each(v reflect.Value, fn func(reflect.Value)) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			each(v.Elem(), fn)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			each(v.Index(i), fn)
+		}
+	default:
+		fn(v)
+	}
+}
+
+// defaultString returns parameters 'p' joined by [ParamSep] if 'v' is
+// empty.
+func defaultString(v string, p []string) string {
+	if v != "" {
+		return v
+	}
+	return strings.Join(p, ParamSep)
+}
+
+// printable returns 'r', or -1 if it is not printable, so it is dropped
+// by [strings.Map].
+func printable(r rune) rune {
+	if !unicode.IsPrint(r) {
+		return -1
+	}
+	return r
+}
@@ -0,0 +1,91 @@
+package validator_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Profile struct {
+	Email string   `json:"email" v:"email" mod:"trim|lower"`
+	Name  string   `json:"name" v:"alphadash" mod:"strip|collapse|title"`
+	Bio   *string  `json:"bio" mod:"trim|default:n/a"`
+	Tags  []string `json:"tags" mod:"trim|upper"`
+}
+
+func ExampleValidator_Modify() {
+	var bio = "  "
+	var p = Profile{
+		Email: "  Jane@Example.COM ",
+		Name:  "jane \t doe\x00",
+		Bio:   &bio,
+		Tags:  []string{" go ", "sql"},
+	}
+
+	v := validator.New()
+	if err := v.Modify(&p); err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%q %q %q %q\n", p.Email, p.Name, *p.Bio, p.Tags)
+
+	// Modifiers are applied before rules of struct pointers.
+	result, err := v.ValidateStruct(&p)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// "jane@example.com" "Jane Doe" "n/a" ["GO" "SQL"]
+	// [{name [must be alphaNeric characters, dash, and underscore]}]
+}
+
+type Handle struct {
+	Name string `json:"name" v:"alphadash" mod:"trim|slug"`
+}
+
+func ExampleValidator_RegisterTransform() {
+	v := validator.New()
+	err := v.RegisterTransform("slug", func(s string, _ []string) string {
+		return strings.Join(strings.Fields(strings.ToLower(s)), "-")
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var h = Handle{Name: " Jane  Doe "}
+	result, err := v.ValidateStruct(&h)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(h.Name, result)
+
+	// Modifiers are registered to a single validator.
+	_, err = validator.New().Compile(Handle{})
+	fmt.Println(err)
+	// Output:
+	// jane-doe []
+	// validator: field name: unsupported modifier: slug
+}
+
+func ExampleValidator_RegisterTransform_panic() {
+	v := validator.New()
+	err := v.RegisterTransform("slug", func(s string, _ []string) string {
+		if s == "" {
+			panic("empty name")
+		}
+		return strings.ToLower(s)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	// Panics of modifiers are returned as errors.
+	_, err = v.ValidateStruct(&Handle{})
+	fmt.Println(err)
+	// Output:
+	// validator: field name: slug: modifier panicked: empty name
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/n4x2/zoo/to"
+)
+
+// Indicates that a value or parameter is not a number.
+var errNotNumber = errors.New("not a number")
+
+// OrderedKinds supported by rules comparing numbers: numeric kinds,
+// decimal strings and math/big values.
+var OrderedKinds = append([]reflect.Kind{reflect.String, reflect.Struct}, NumericKinds...)
+
+// Compare compares numeric value 'v' with parameter 'p' without losing
+// precision, it returns -1 if 'v' is less than 'p', 0 if equal and +1 if
+// greater. Integers are compared as int64 or uint64 when 'p' fits in the
+// same kind, floats with 'p' rounded to their precision, decimal strings,
+// math/big values and other combinations exactly as rational numbers. It
+// returns an error if 'v' or 'p' is not a number, including NaN.
+func Compare(v, p any) (int, error) {
+	ps, err := to.String(p)
+	if err != nil {
+		return 0, errNotNumber
+	}
+
+	var rv = reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if pi, err := strconv.ParseInt(ps, 10, 64); err == nil {
+			return cmp.Compare(rv.Int(), pi), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if pu, err := strconv.ParseUint(ps, 10, 64); err == nil {
+			return cmp.Compare(rv.Uint(), pu), nil
+		}
+	case reflect.Float32, reflect.Float64:
+		pf, err := strconv.ParseFloat(ps, rv.Type().Bits())
+		if err != nil && !errors.Is(err, strconv.ErrRange) || math.IsNaN(pf) || math.IsNaN(rv.Float()) {
+			return 0, errNotNumber
+		}
+		return cmp.Compare(rv.Float(), pf), nil
+	}
+
+	a, ok := rat(v)
+	if !ok {
+		return 0, errNotNumber
+	}
+
+	b, ok := new(big.Rat).SetString(ps)
+	if !ok {
+		return 0, errNotNumber
+	}
+	return a.Cmp(b), nil
+}
+
+// rat converts 'v' into rational number. It accepts numeric kinds, decimal
+// strings, math/big values and non-nil pointers to them.
+func rat(v any) (*big.Rat, bool) {
+	switch pv := v.(type) {
+	case *big.Int:
+		return new(big.Rat).SetInt(pv), pv != nil
+	case big.Int:
+		return new(big.Rat).SetInt(&pv), true
+	case *big.Rat:
+		return pv, pv != nil
+	case big.Rat:
+		return &pv, true
+	case *big.Float:
+		if pv == nil || pv.IsInf() {
+			return nil, false
+		}
+		r, _ := pv.Rat(nil)
+		return r, true
+	case big.Float:
+		return rat(&pv)
+	}
+
+	var rv = reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(rv.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat).SetFloat64(rv.Float())
+		return r, r != nil
+	case reflect.String:
+		return new(big.Rat).SetString(rv.String())
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, false
+		}
+		return rat(rv.Elem().Interface())
+	default:
+		return nil, false
+	}
+}
+
+// compare compares value 'v' with each parameter of tag 't', returning
+// sign of difference of each parameter from the value. It returns an
+// error if the value or a parameter is not a number.
+func compare(v any, t Tag) ([]int, error) {
+	var c = make([]int, len(t.P))
+	for i, tp := range t.P {
+		pc, err := Compare(v, tp)
+		if err != nil {
+			return nil, &errTypeConversion{tn: t.N, t: "number", v: v}
+		}
+		c[i] = -pc
+	}
+	return c, nil
+}
@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/n4x2/zoo/is"
+)
+
+// PasswordRule is name of rule validating passwords against policy, e.g.
+// "password:min=10,upper,digit,repeat=2,forbid=acme;zoo".
+const PasswordRule = "password"
+
+// Indicates that a parameter of [PasswordRule] is malformed.
+var errPasswordParam = errors.New("invalid password policy")
+
+// ParsePasswordPolicy parses parameters 'p' of [PasswordRule] into policy
+// of [is.Password]. Parameters are requirements:
+//
+//	min=N      at least N characters
+//	upper      an uppercase letter
+//	lower      a lowercase letter
+//	digit      a digit
+//	symbol     a punctuation or symbol character
+//	repeat=N   at most N consecutive repeated characters
+//	forbid     none of forbidden sequences of [is.DefaultPasswordPolicy]
+//	forbid=a;b none of sequences "a" and "b"
+//	entropy=N  at least N bits of entropy, see [is.PasswordEntropy]
+//
+// Without parameters it returns [is.DefaultPasswordPolicy]. It returns an
+// error if a parameter is unknown or its value is invalid.
+func ParsePasswordPolicy(p ...string) (is.PasswordPolicy, error) {
+	if len(p) == 0 {
+		return is.DefaultPasswordPolicy, nil
+	}
+
+	var policy is.PasswordPolicy
+	for _, param := range p {
+		var n, v, ok = strings.Cut(strings.TrimSpace(param), "=")
+
+		var err error
+		switch {
+		case n == "min" && ok:
+			policy.MinLength, err = strconv.Atoi(v)
+		case n == "repeat" && ok:
+			policy.MaxRepeat, err = strconv.Atoi(v)
+		case n == "entropy" && ok:
+			policy.MinEntropy, err = strconv.ParseFloat(v, 64)
+		case n == "forbid" && ok:
+			policy.Forbidden = strings.Split(v, ";")
+		case n == "forbid":
+			policy.Forbidden = is.DefaultPasswordPolicy.Forbidden
+		case n == "upper" && !ok:
+			policy.Upper = true
+		case n == "lower" && !ok:
+			policy.Lower = true
+		case n == "digit" && !ok:
+			policy.Digit = true
+		case n == "symbol" && !ok:
+			policy.Symbol = true
+		default:
+			err = errPasswordParam
+		}
+
+		if err != nil {
+			return is.PasswordPolicy{}, fmt.Errorf("%w: %s", errPasswordParam, param)
+		}
+	}
+	return policy, nil
+}
+
+// PasswordMessage returns message of [PasswordRule] listing requirements
+// 'unmet' of policy 'p', as returned by [is.Password], e.g. "must have at
+// least 12 characters, a digit".
+func PasswordMessage(p is.PasswordPolicy, unmet []string) string {
+	return fmt.Sprintf(E[PasswordRule], requirements(p, unmet))
+}
+
+// requirements returns descriptions of requirements 'unmet' of policy
+// 'p' joined by comma.
+func requirements(p is.PasswordPolicy, unmet []string) string {
+	var s = make([]string, 0, len(unmet))
+	for _, u := range unmet {
+		switch u {
+		case "length":
+			s = append(s, fmt.Sprintf("at least %d characters", p.MinLength))
+		case "upper":
+			s = append(s, "an uppercase letter")
+		case "lower":
+			s = append(s, "a lowercase letter")
+		case "digit":
+			s = append(s, "a digit")
+		case "symbol":
+			s = append(s, "a symbol")
+		case "repeat":
+			s = append(s, fmt.Sprintf("at most %d repeated characters", p.MaxRepeat))
+		case "sequence":
+			s = append(s, "no forbidden sequence")
+		case "entropy":
+			s = append(s, fmt.Sprintf("at least %v bits of entropy", p.MinEntropy))
+		}
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,41 @@
+package validator_test
+
+import (
+	"fmt"
+
+	"github.com/n4x2/zoo/is"
+	"github.com/n4x2/zoo/validator"
+)
+
+type Credentials struct {
+	Password string `json:"password" v:"password"`
+	PIN      string `json:"pin" v:"password:min=6,digit,repeat=2,forbid=1234;0000"`
+}
+
+func Example_password() {
+	var c = Credentials{Password: "Password1", PIN: "111234"}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(c)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{password [must have at least 12 characters, a symbol, no forbidden sequence, at least 60 bits of entropy]} {pin [must have at most 2 repeated characters, no forbidden sequence]}]
+}
+
+func ExampleParsePasswordPolicy() {
+	p, err := validator.ParsePasswordPolicy("min=8", "upper", "entropy=40")
+	if err != nil {
+		panic(err)
+	}
+
+	u := is.Password("kettle", p)
+	fmt.Println(u)
+	fmt.Println(validator.PasswordMessage(p, u))
+	// Output:
+	// [length upper entropy]
+	// must have at least 8 characters, an uppercase letter, at least 40 bits of entropy
+}
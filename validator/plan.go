@@ -0,0 +1,330 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	// RuleSet holds validation rules compiled from tags of a struct type,
+	// it is safe for concurrent use.
+	RuleSet struct {
+		t      reflect.Type // The struct type.
+		fields []fieldRule  // Rules of struct fields.
+	}
+
+	// fieldRule holds validation rules of a struct field.
+	fieldRule struct {
+		name   string       // The field name.
+		t      reflect.Type // The field type.
+		expr   *Expr        // Validation rules, nil if the field is not validated.
+		mods   []modifier   // Modifiers of [ModTag].
+		def    *string      // Value of [DefaultTag], nil if missing.
+		nested *RuleSet     // Rules of struct, slice or array of struct field.
+	}
+)
+
+// errUnsupportedKind an error type for cases where a rule does not
+// support kind of the field it is applied to.
+type errUnsupportedKind struct {
+	st string       // The struct name.
+	f  string       // The field name.
+	tn string       // The tag name.
+	t  reflect.Type // The field type.
+}
+
+// Error an error for the errUnsupportedKind type.
+func (e *errUnsupportedKind) Error() string {
+	return fmt.Sprintf("struct %s: field %s: tag %s does not support %s", e.st, e.f, e.tn, e.t)
+}
+
+// Type returns struct type of the rule set.
+func (rs *RuleSet) Type() reflect.Type {
+	return rs.t
+}
+
+// field returns rule of field named 'n', falling back to case insensitive
+// match like [json.Unmarshal].
+func (rs *RuleSet) field(n string) (int, bool) {
+	for i := range rs.fields {
+		if rs.fields[i].name == n {
+			return i, true
+		}
+	}
+
+	for i := range rs.fields {
+		if strings.EqualFold(rs.fields[i].name, n) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// siblings checks if expressions of [ExprRule] of the field refer to
+// sibling fields.
+func (f *fieldRule) siblings() bool {
+	var ok bool
+	if f.expr != nil {
+		f.expr.Walk(func(t Tag) {
+			if t.N != ExprRule || len(t.P) != 1 {
+				return
+			}
+
+			if p, isProg := t.P[0].(*Program); isProg && len(p.Fields()) > 0 {
+				ok = true
+			}
+		})
+	}
+	return ok
+}
+
+// compile compiles validation rules of struct type 't'. It returns an
+// error if a field is unexported, missing validator tag, fail to parse
+// tag, or a rule does not support kind of the field.
+func (r *Validator) compile(t reflect.Type, seen map[reflect.Type]*RuleSet) (*RuleSet, error) {
+	if rs, ok := seen[t]; ok {
+		return rs, nil
+	}
+
+	var rs = &RuleSet{t: t, fields: make([]fieldRule, t.NumField())}
+	seen[t] = rs
+
+	for i := range rs.fields {
+		var fi = t.Field(i)
+		var f = &rs.fields[i]
+
+		f.name, f.t = fieldName(fi), fi.Type
+
+		ft, ok := r.structTag(t, fi)
+		mt, hasMod := fi.Tag.Lookup(ModTag)
+		dt, hasDef := fi.Tag.Lookup(DefaultTag)
+		if !ok && !hasMod && !hasDef && r.registered(t) {
+			// Fields of structs with registered rules are only
+			// validated if they have rules.
+			continue
+		}
+
+		if fi.PkgPath != "" {
+			return nil, fmt.Errorf("field %s : %w", fi.Name, errUnexportedField)
+		}
+
+		if hasMod {
+			m, err := r.mods(mt)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.name, err)
+			}
+
+			if elem(fi.Type).Kind() != reflect.String {
+				return nil, &errUnsupportedKind{st: t.String(), f: f.name, tn: ModTag, t: fi.Type}
+			}
+			f.mods = m
+		}
+
+		if hasDef {
+			if err := defaultValue(fi.Type, dt); err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.name, err)
+			}
+			f.def = &dt
+		}
+
+		if !ok && (hasMod || hasDef) {
+			// Fields having only modifiers or default are not
+			// validated, but nested structs are modified.
+			nested, err := r.nested(fi.Type, seen)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", f.name, err)
+			}
+			f.nested = nested
+			continue
+		}
+
+		if !ok || ft == "" {
+			return nil, fmt.Errorf("field %s: %w", f.name, errMissingTag)
+		}
+
+		pe, err := r.parseExpr(ft)
+		if err == nil {
+			err = r.programs(pe, t)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.name, err)
+		}
+		f.expr = pe
+
+		var unsupported string
+		pe.Walk(func(tag Tag) {
+			if unsupported == "" && !supports(r.rules[tag.N].Kinds, fi.Type) && !r.unwraps(fi.Type) {
+				unsupported = tag.N
+			}
+		})
+
+		if unsupported != "" {
+			return nil, &errUnsupportedKind{st: t.String(), f: f.name, tn: unsupported, t: fi.Type}
+		}
+
+		nested, err := r.nested(fi.Type, seen)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.name, err)
+		}
+		f.nested = nested
+	}
+	return rs, nil
+}
+
+// nested compiles rules of struct, slice or array of struct field type
+// 't' if the struct has tags or registered rules, or returns nil.
+func (r *Validator) nested(t reflect.Type, seen map[reflect.Type]*RuleSet) (*RuleSet, error) {
+	if et := elem(t); et.Kind() == reflect.Struct && (tagged(et) || r.registered(et)) {
+		return r.compile(et, seen)
+	}
+	return nil, nil
+}
+
+// programs compiles parameters of [ExprRule] rules of expression 'e' into
+// [Program]. It returns an error if an expression is malformed or refers
+// to a field missing from struct type 't', fields are not checked if 't'
+// is nil.
+func (r *Validator) programs(e *Expr, t reflect.Type) error {
+	if e.Op != OpRule {
+		for _, x := range e.X {
+			if err := r.programs(x, t); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if e.Tag.N != ExprRule || len(e.Tag.P) != 1 {
+		return nil
+	}
+
+	ps, _ := e.Tag.P[0].(string)
+	p, err := r.ParseProgram(ps)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ExprRule, err)
+	}
+
+	for _, n := range p.Fields() {
+		if t == nil {
+			break
+		}
+
+		if _, ok := fieldIndex(t, n); !ok {
+			return fmt.Errorf("%s: %w: .%s", ExprRule, errUnknownField, n)
+		}
+	}
+
+	e.Tag.P = []any{p}
+	return nil
+}
+
+// supports checks if a rule declaring 'kinds' supports values of type
+// 't', pointer types are checked by their element type. Rules without
+// declared kinds and interface types are accepted, as their values are
+// only known at validation time.
+func supports(kinds []reflect.Kind, t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if kinds == nil || t.Kind() == reflect.Interface {
+		return true
+	}
+
+	for _, k := range kinds {
+		if t.Kind() == k {
+			return true
+		}
+	}
+	return false
+}
+
+// tagged checks if any field of struct type 't' has validator tag,
+// [ModTag] or [DefaultTag].
+func tagged(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		for _, tag := range []string{ValidatorTag, ModTag, DefaultTag} {
+			if _, ok := t.Field(i).Tag.Lookup(tag); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// elem returns element type of pointer, slice and array type 't',
+// dereferenced until other kind.
+func elem(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t
+}
+
+// plan returns rule set of struct type 't' from the cache, compiling it
+// if not yet cached.
+func (r *Validator) plan(t reflect.Type) (*RuleSet, error) {
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validator: %w", errInvalidInput)
+	}
+
+	if rs, ok := r.plans.Load(t); ok {
+		return rs.(*RuleSet), nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.store(t)
+}
+
+// store compiles rule set of struct type 't' and caches it, the caller
+// holds the lock of the validator.
+func (r *Validator) store(t reflect.Type) (*RuleSet, error) {
+	rs, err := r.compile(t, make(map[reflect.Type]*RuleSet))
+	if err != nil {
+		return nil, fmt.Errorf("validator: %w", err)
+	}
+
+	pv, _ := r.plans.LoadOrStore(t, rs)
+	return pv.(*RuleSet), nil
+}
+
+// reset discards cached rule sets, so they are compiled again with rules
+// changed since.
+func (r *Validator) reset() {
+	r.plans.Range(func(k, _ any) bool {
+		r.plans.Delete(k)
+		return true
+	})
+}
+
+// Compile compiles validation rules from tags of struct 'v' into
+// [RuleSet], rules of nested structs with validator tags are compiled as
+// well. Rule sets are cached per type and used by [Validator.ValidateStruct].
+// It returns an error if 'v' is not a struct, a field is unexported,
+// missing validator tag, fail to parse tag, or a rule does not support
+// kind of the field, e.g. "lowercase" on an int8.
+func (r *Validator) Compile(v any) (*RuleSet, error) {
+	return r.plan(reflect.TypeOf(v))
+}
+
+// MustCompile compiles validation rules of struct type T with default
+// rules like [Validator.Compile], pointer types are dereferenced. It
+// panics if compiling fails, so invalid tags are reported at program
+// start:
+//
+//	var _ = validator.MustCompile[Customer]()
+func MustCompile[T any]() *RuleSet {
+	var t = reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	rs, err := std.plan(t)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
@@ -0,0 +1,54 @@
+package validator_test
+
+import (
+	"fmt"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Level struct {
+	Code int8 `json:"code" v:"lowercase"`
+}
+
+type Badge struct {
+	Code int8   `json:"code" v:"gt:0"`
+	Name string `json:"name"`
+}
+
+func ExampleValidator_Compile() {
+	for _, v := range []any{Level{}, Badge{}} {
+		_, err := validator.New().Compile(v)
+		fmt.Println(err)
+	}
+	// Output:
+	// validator: struct validator_test.Level: field code: tag lowercase does not support int8
+	// validator: field name: missing validator tag
+}
+
+func ExampleMustCompile() {
+	rs := validator.MustCompile[*Item]()
+	fmt.Println(rs.Type())
+	// Output:
+	// validator_test.Item
+}
+
+type Referral struct {
+	Email *string `json:"email" v:"email"`
+	Code  *int    `json:"code" v:"gt:0"`
+}
+
+func ExampleValidator_Compile_pointer() {
+	// Pointer fields are checked and validated by their element, nil
+	// pointers are absent.
+	var email, code = "jane", 0
+	for _, v := range []Referral{{}, {Email: &email, Code: &code}} {
+		res, err := validator.New().ValidateStruct(v)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(res)
+	}
+	// Output:
+	// []
+	// [{email [invalid email address]} {code [must be greater than 0]}]
+}
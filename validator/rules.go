@@ -0,0 +1,179 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Indicates that a rule names a field missing from the struct.
+var errFieldNotFound = errors.New("field not found")
+
+// RegisterStructRules registers validation rules of struct 'v' outside of
+// its tags, e.g. for types of other packages. Keys of 'rules' are field
+// names or [JSONTag] names, values are validator tags. A rule replaces the
+// field tag, unless it starts with [TagSep], e.g. "|lte:100", then it is
+// added to the tag. Fields without rule nor tag are not validated. Rules
+// of the type registered before are replaced and compiled rule sets are
+// discarded, so rules can be reloaded at runtime. It returns an error if
+// 'v' is not a struct, a field is not found or rules fail to compile,
+// keeping rules registered before.
+func (r *Validator) RegisterStructRules(v any, rules map[string]string) error {
+	var t = reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return fmt.Errorf("validator: %w", errInvalidInput)
+	}
+
+	var fr = make(map[string]string, len(rules))
+	for n, rule := range rules {
+		f, ok := structField(t, n)
+		if !ok {
+			return fmt.Errorf("validator: struct %s: %w: %s", t, errFieldNotFound, n)
+		}
+		fr[f.Name] = rule
+	}
+
+	// Rule sets are not compiled by others until the rules are checked,
+	// so none of them is cached with rules failing to compile.
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.structs.Swap(t, fr)
+	r.reset()
+
+	if _, err := r.store(t); err != nil {
+		if ok {
+			r.structs.Store(t, prev)
+		} else {
+			r.structs.Delete(t)
+		}
+		r.reset()
+		return err
+	}
+	return nil
+}
+
+// structField returns field of struct type 't' named 'n' or by [JSONTag]
+// name 'n'.
+func structField(t reflect.Type, n string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Name == n {
+			return t.Field(i), true
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if fieldName(t.Field(i)) == n {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// LoadRules reads rules of structs 'v' from YAML or JSON document 'src',
+// and registers them like [Validator.RegisterStructRules]. The document
+// maps type names, optionally qualified by package name, into rules of
+// their fields:
+//
+//	Customer:
+//	  Email: email
+//	  Age: "|lte:120"
+//	billing.Invoice:
+//	  Total: decimal:10,2
+//
+// Rules starting with [SkipTag] must be quoted in YAML. It returns an
+// error if the document is malformed, names a type missing from 'v' or
+// shared by several types of 'v', e.g. "Invoice" of two packages, or rules
+// fail to register.
+func (r *Validator) LoadRules(src io.Reader, v ...any) error {
+	var doc map[string]map[string]string
+	if err := yaml.NewDecoder(src).Decode(&doc); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("validator: load rules: %w", err)
+	}
+
+	var types = make(map[string]reflect.Type, len(v)*2)
+	var ambiguous = make(map[string]bool)
+	for _, sv := range v {
+		var t = reflect.TypeOf(sv)
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+
+		if t == nil {
+			return fmt.Errorf("validator: %w", errInvalidInput)
+		}
+
+		for _, n := range []string{t.Name(), t.String()} {
+			if dt, ok := types[n]; ok && dt != t {
+				ambiguous[n] = true
+			}
+			types[n] = t
+		}
+	}
+
+	for n, rules := range doc {
+		t, ok := types[n]
+		if !ok {
+			return fmt.Errorf("validator: load rules: type %s not found", n)
+		}
+
+		if ambiguous[n] {
+			return fmt.Errorf("validator: load rules: type %s is ambiguous", n)
+		}
+
+		if err := r.RegisterStructRules(reflect.Zero(t).Interface(), rules); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadRulesFile reads rules of structs 'v' from YAML or JSON file 'name',
+// see [Validator.LoadRules].
+func (r *Validator) LoadRulesFile(name string, v ...any) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("validator: %w", err)
+	}
+	defer f.Close()
+
+	return r.LoadRules(f, v...)
+}
+
+// structTag returns validator tag of field 'f' of struct type 't', with
+// rules registered by [Validator.RegisterStructRules]. It returns false if
+// the field has no rules.
+func (r *Validator) structTag(t reflect.Type, f reflect.StructField) (string, bool) {
+	var tag, ok = f.Tag.Lookup(ValidatorTag)
+
+	rules, registered := r.structs.Load(t)
+	if !registered {
+		return tag, ok
+	}
+
+	rule, has := rules.(map[string]string)[f.Name]
+	switch {
+	case !has:
+		return tag, ok && tag != ""
+	case strings.HasPrefix(rule, TagSep) && ok && tag != "":
+		return "(" + tag + ")" + rule, true
+	default:
+		return strings.TrimPrefix(rule, TagSep), true
+	}
+}
+
+// registered checks if rules of struct type 't' are registered by
+// [Validator.RegisterStructRules].
+func (r *Validator) registered(t reflect.Type) bool {
+	_, ok := r.structs.Load(t)
+	return ok
+}
@@ -0,0 +1,82 @@
+package validator_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+// Vendor is a type without validator tags, e.g. from another package.
+type Vendor struct {
+	Name   string
+	Email  string
+	Rating int
+	note   string
+}
+
+type Quote struct {
+	Amount int `json:"amount" v:"gt:0"`
+}
+
+func ExampleValidator_RegisterStructRules() {
+	v := validator.New()
+	err := v.RegisterStructRules(Vendor{}, map[string]string{
+		"Email":  "email",
+		"Rating": "range:1,5",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := v.ValidateStruct(Vendor{Name: "ACME", Email: "acme", Rating: 9})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{Email [invalid email address]} {Rating [value must be in range 1-5]}]
+}
+
+func ExampleValidator_LoadRules() {
+	const rules = `
+validator_test.Quote:
+  amount: "|lte:1000"
+`
+
+	v := validator.New()
+	if err := v.LoadRules(strings.NewReader(rules), Quote{}); err != nil {
+		panic(err)
+	}
+
+	result, err := v.ValidateStruct(Quote{Amount: 5000})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+
+	// Rules can be reloaded, e.g. when the file changes.
+	if err := v.LoadRules(strings.NewReader(`{"Quote": {"Amount": "|lte:10000"}}`), Quote{}); err != nil {
+		panic(err)
+	}
+
+	result, err = v.ValidateStruct(Quote{Amount: 5000})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+
+	// Names shared by several types are ambiguous.
+	var quote any = Quote{}
+	{
+		type Quote struct{ Amount int }
+		fmt.Println(v.LoadRules(strings.NewReader(rules), quote, Quote{}))
+	}
+	// Output:
+	// [{amount [must be less than or equal to 1000]}]
+	// []
+	// validator: load rules: type validator_test.Quote is ambiguous
+}
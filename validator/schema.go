@@ -0,0 +1,305 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/n4x2/zoo/regex"
+	"github.com/n4x2/zoo/to"
+)
+
+// SchemaDialect is JSON Schema dialect of documents generated by
+// [Validator.JSONSchema].
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Formats maps validator tags into JSON Schema "format" keyword.
+var Formats = map[string]string{
+	"email":   "email",
+	"rfc3339": "date-time",
+	"uuid":    "uuid",
+}
+
+// Patterns maps validator tags into JSON Schema "pattern" keyword.
+var Patterns = map[string]string{
+	"alpha":     regex.Alpha.String(),
+	"alphadash": regex.AlphaDash.String(),
+	"alphanum":  regex.AlphaNumeric.String(),
+	"ascii":     regex.ASCII.String(),
+	"e164":      regex.E164.String(),
+	"lat":       regex.Latitude.String(),
+	"lon":       regex.Longitude.String(),
+	"lowercase": "^[^A-Z]*$",
+	"ulid":      regex.ULID.String(),
+	"uppercase": "^[^a-z]*$",
+}
+
+// Schema represents a JSON Schema document or subschema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Const                any                `json:"const,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// schemaBuilder holds state of schema generation.
+type schemaBuilder struct {
+	r     *Validator
+	defs  map[string]*Schema
+	names map[reflect.Type]string
+}
+
+// JSONSchema generates JSON Schema (draft 2020-12) document of struct 'v'.
+// Properties are named like [Validator.ValidateStruct] results, using
+// [JSONTag] names. Validator tags are mapped into keywords: "gt", "gte",
+// "lt", "lte", "range" and "equal" into numeric bounds, "enum" into enum,
+// tags listed in [Formats] and [Patterns] into format and pattern,
+// alternatives joined by [OrKeyword] into "anyOf" and rules negated by
+// [NotKeyword] into "not". Nested structs are placed in "$defs". Fields
+// are required if their zero value, which missing keys are validated as by
+// [Validator.ValidateJSON], fails their rules, e.g. nil pointers failing
+// [RequiredRule] or empty strings failing "alpha". It returns an error if
+// 'v' is not a struct or a tag fails to parse.
+func (r *Validator) JSONSchema(v any) (*Schema, error) {
+	var t = reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validator: %w", errInvalidInput)
+	}
+
+	var b = schemaBuilder{
+		r:     r,
+		defs:  make(map[string]*Schema),
+		names: map[reflect.Type]string{t: "#"},
+	}
+
+	s, err := b.object(t)
+	if err != nil {
+		return nil, fmt.Errorf("validator: %w", err)
+	}
+
+	s.Schema = SchemaDialect
+	s.Title = t.Name()
+	if len(b.defs) > 0 {
+		s.Defs = b.defs
+	}
+	return s, nil
+}
+
+// object generates schema of struct type 't'.
+func (b *schemaBuilder) object(t reflect.Type) (*Schema, error) {
+	var s = &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		var f = t.Field(i)
+		if !f.IsExported() || f.Tag.Get(JSONTag) == SkipTag {
+			continue
+		}
+
+		ps, err := b.schema(f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Name, err)
+		}
+
+		var n = fieldName(f)
+		if ft, ok := b.r.structTag(t, f); ok && ft != "" {
+			pe, err := b.r.parseExpr(ft)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", n, err)
+			}
+			ps = constrainExpr(ps, pe)
+
+			// Expressions referring to siblings fail without scope, so
+			// their fields are not required.
+			m, err := b.r.validateExpr(reflect.Zero(f.Type).Interface(), pe, nil, true)
+			if err == nil && len(m) > 0 {
+				s.Required = append(s.Required, n)
+			}
+		}
+		s.Properties[n] = ps
+	}
+	return s, nil
+}
+
+// schema generates schema of type 't'.
+func (b *schemaBuilder) schema(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case reflect.TypeOf(time.Duration(0)):
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}, nil
+		}
+
+		items, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		items, err := b.schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: items}, nil
+	case reflect.Struct:
+		return b.ref(t)
+	default:
+		return &Schema{}, nil
+	}
+}
+
+// ref returns reference to definition of struct type 't', adding it to
+// "$defs" if not yet defined. Anonymous structs are defined inline.
+func (b *schemaBuilder) ref(t reflect.Type) (*Schema, error) {
+	if t.Name() == "" {
+		return b.object(t)
+	}
+
+	if n, ok := b.names[t]; ok {
+		if n == "#" {
+			return &Schema{Ref: n}, nil
+		}
+		return &Schema{Ref: "#/$defs/" + n}, nil
+	}
+
+	var n = t.Name()
+	for i := 2; b.defs[n] != nil; i++ {
+		n = fmt.Sprintf("%s%d", t.Name(), i)
+	}
+
+	b.names[t] = n
+	b.defs[n] = &Schema{}
+
+	s, err := b.object(t)
+	if err != nil {
+		return nil, err
+	}
+
+	b.defs[n] = s
+	return &Schema{Ref: "#/$defs/" + n}, nil
+}
+
+// constrainExpr adds keywords equivalent to validator tag expression 'e'
+// into 's'. Alternatives are mapped into "anyOf" of their own "allOf" entry
+// and negations into "not".
+func constrainExpr(s *Schema, e *Expr) *Schema {
+	switch e.Op {
+	case OpRule:
+		return constrain(s, e.Tag)
+	case OpAnd:
+		for _, x := range e.X {
+			s = constrainExpr(s, x)
+		}
+		return s
+	case OpAlias:
+		return constrainExpr(s, e.X[0])
+	case OpOr:
+		var os = &Schema{}
+		for _, x := range e.X {
+			os.AnyOf = append(os.AnyOf, constrainExpr(&Schema{}, x))
+		}
+		s.AllOf = append(s.AllOf, os)
+		return s
+	default:
+		var ns = constrainExpr(&Schema{}, e.X[0])
+		if s.Not == nil {
+			s.Not = ns
+		} else {
+			s.AllOf = append(s.AllOf, &Schema{Not: ns})
+		}
+		return s
+	}
+}
+
+// constrain adds keywords equivalent to validator tag 't' into 's'.
+func constrain(s *Schema, t Tag) *Schema {
+	var num = func(i int) *float64 {
+		f, err := to.Float64(t.P[i])
+		if err != nil {
+			return nil
+		}
+		return &f
+	}
+
+	switch t.N {
+	case "gt":
+		s.ExclusiveMinimum = num(0)
+	case "gte":
+		s.Minimum = num(0)
+	case "lt":
+		s.ExclusiveMaximum = num(0)
+	case "lte":
+		s.Maximum = num(0)
+	case "range":
+		s.Minimum, s.Maximum = num(0), num(1)
+	case "equal":
+		if f := num(0); f != nil {
+			s.Const = *f
+		}
+	case "enum":
+		s.Enum = make([]any, len(t.P))
+		for i, p := range t.P {
+			s.Enum[i], _ = to.String(p)
+		}
+	case "datetime":
+		switch p, _ := to.String(t.P[0]); p {
+		case time.DateOnly:
+			s.Format = "date"
+		case time.TimeOnly:
+			s.Format = "time"
+		case time.RFC3339:
+			s.Format = "date-time"
+		}
+	}
+
+	if f, ok := Formats[t.N]; ok {
+		s.Format = f
+	}
+
+	if p, ok := Patterns[t.N]; ok {
+		if s.Pattern == "" {
+			s.Pattern = p
+		} else {
+			s.AllOf = append(s.AllOf, &Schema{Pattern: p})
+		}
+	}
+	return s
+}
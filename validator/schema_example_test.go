@@ -0,0 +1,128 @@
+package validator_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Address struct {
+	City string  `json:"city" v:"alpha"`
+	Zip  *string `json:"zip" v:"alphanum"`
+	Ref  *string `json:"ref" v:"(uuid or ulid)|(lowercase or uppercase)"`
+}
+
+type Customer struct {
+	ID      string   `json:"id" v:"uuid"`
+	Email   string   `json:"email" v:"email"`
+	Name    string   `json:"name,omitempty" v:"alpha|lowercase"`
+	Age     int      `json:"age" v:"gt:17|lte:120"`
+	Tier    string   `json:"tier" v:"enum:basic,gold"`
+	Address *Address `json:"address" v:"required"`
+}
+
+func ExampleValidator_JSONSchema() {
+	s, err := validator.New().JSONSchema(Customer{})
+	if err != nil {
+		panic(err)
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(b))
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "title": "Customer",
+	//   "type": "object",
+	//   "properties": {
+	//     "address": {
+	//       "$ref": "#/$defs/Address"
+	//     },
+	//     "age": {
+	//       "type": "integer",
+	//       "maximum": 120,
+	//       "exclusiveMinimum": 17
+	//     },
+	//     "email": {
+	//       "type": "string",
+	//       "format": "email"
+	//     },
+	//     "id": {
+	//       "type": "string",
+	//       "format": "uuid"
+	//     },
+	//     "name": {
+	//       "type": "string",
+	//       "pattern": "^[a-zA-Z]+$",
+	//       "allOf": [
+	//         {
+	//           "pattern": "^[^A-Z]*$"
+	//         }
+	//       ]
+	//     },
+	//     "tier": {
+	//       "type": "string",
+	//       "enum": [
+	//         "basic",
+	//         "gold"
+	//       ]
+	//     }
+	//   },
+	//   "required": [
+	//     "id",
+	//     "email",
+	//     "name",
+	//     "age",
+	//     "tier",
+	//     "address"
+	//   ],
+	//   "$defs": {
+	//     "Address": {
+	//       "type": "object",
+	//       "properties": {
+	//         "city": {
+	//           "type": "string",
+	//           "pattern": "^[a-zA-Z]+$"
+	//         },
+	//         "ref": {
+	//           "type": "string",
+	//           "allOf": [
+	//             {
+	//               "anyOf": [
+	//                 {
+	//                   "format": "uuid"
+	//                 },
+	//                 {
+	//                   "pattern": "^[A-HJKMNP-TV-Z0-9]{26}$"
+	//                 }
+	//               ]
+	//             },
+	//             {
+	//               "anyOf": [
+	//                 {
+	//                   "pattern": "^[^A-Z]*$"
+	//                 },
+	//                 {
+	//                   "pattern": "^[^a-z]*$"
+	//                 }
+	//               ]
+	//             }
+	//           ]
+	//         },
+	//         "zip": {
+	//           "type": "string",
+	//           "pattern": "^[a-zA-Z0-9]+$"
+	//         }
+	//       },
+	//       "required": [
+	//         "city"
+	//       ]
+	//     }
+	//   }
+	// }
+}
@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Unwrapper returns underlying value of 'v' validated by rules, e.g. the
+// string of a custom nullable type, or nil if 'v' holds no value.
+type Unwrapper func(v any) any
+
+// Unwrappers holds unwrappers of values by type. Validators copy them when
+// created by [New], others are added to a single validator by
+// [Validator.RegisterUnwrapper].
+var Unwrappers = map[reflect.Type]Unwrapper{}
+
+var (
+	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	textType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// basicTypes maps kinds into predeclared types named types are converted
+// into by [Validator.unwrap].
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(0),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Float32: reflect.TypeOf(float32(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
+}
+
+// RegisterUnwrapper registers unwrapper 'fn' of values of the same type as
+// 'v', it takes precedence over unwrapping done by [Validator.ValidateField].
+// Compiled rule sets are discarded, as kinds of fields are checked against
+// unwrapped types. It returns an error if 'v' is nil or unwrapper of the
+// type already exists.
+func (r *Validator) RegisterUnwrapper(v any, fn Unwrapper) error {
+	var t = reflect.TypeOf(v)
+	if t == nil {
+		return errors.New("unwrapper type must not be nil")
+	}
+
+	if _, exists := r.unwrappers[t]; exists {
+		return errors.New("unwrapper of " + t.String() + " already exists")
+	}
+
+	r.unwrappers[t] = fn
+	r.reset()
+	return nil
+}
+
+// unwrap returns underlying value of 'v' before rules are applied: value
+// of registered [Unwrapper], nil if 'v' is a nil pointer, value of
+// [driver.Valuer] such as sql.NullString, nil if it is not valid, or value
+// of named string, bool or number type converted into its predeclared
+// type. Other values and named types having text of
+// [encoding.TextMarshaler] or [fmt.Stringer] are returned as is, their
+// text is used by rules validating strings, see [str].
+func (r *Validator) unwrap(v any) any {
+	var rv = reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return nil
+	}
+
+	if fn, ok := r.unwrappers[rv.Type()]; ok {
+		return fn(v)
+	}
+
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil
+	}
+
+	if dv, ok := v.(driver.Valuer); ok {
+		val, err := dv.Value()
+		if err != nil {
+			return v
+		}
+
+		if b, ok := val.([]byte); ok {
+			return string(b)
+		}
+		return val
+	}
+
+	var t = rv.Type()
+	if bt, ok := basicTypes[rv.Kind()]; ok && t != bt && !text(t) {
+		return rv.Convert(bt).Interface()
+	}
+	return v
+}
+
+// unwraps checks if values of type 't' may be unwrapped into values of
+// other kinds, so rules are only checked at validation time. time.Time is
+// validated by date-time rules, not as text.
+func (r *Validator) unwraps(t reflect.Type) bool {
+	if _, ok := r.unwrappers[t]; ok {
+		return true
+	}
+	return t.Implements(valuerType) || text(t) && elem(t) != timeType
+}
+
+// text checks if type 't' implements [encoding.TextMarshaler] or
+// [fmt.Stringer].
+func text(t reflect.Type) bool {
+	return t.Implements(textType) || t.Implements(stringType)
+}
@@ -0,0 +1,97 @@
+package validator_test
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/n4x2/zoo/validator"
+)
+
+type Login string
+
+type Tier int
+
+func (t Tier) String() string {
+	return [...]string{"free", "pro"}[t]
+}
+
+type Account struct {
+	Email sql.NullString `json:"email" v:"email"`
+	Age   sql.NullInt64  `json:"age" v:"gte:18"`
+	Login Login          `json:"login" v:"alphadash"`
+	Tier  Tier           `json:"tier" v:"enum:pro"`
+}
+
+func ExampleValidator_ValidateField_unwrap() {
+	v := validator.New()
+
+	for _, val := range []any{
+		sql.NullString{String: "jane@example.com", Valid: true},
+		Login("jane doe"),
+		Tier(0),
+	} {
+		m, err := v.ValidateField(val, []validator.Tag{{N: "alphadash"}})
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(m)
+	}
+
+	result, err := v.ValidateStruct(Account{
+		Email: sql.NullString{String: "jane@example.com", Valid: true},
+		Age:   sql.NullInt64{Int64: 16, Valid: true},
+		Login: "jane_doe",
+		Tier:  1,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+
+	// NULL values are absent, so only rules such as required validate them.
+	result, err = v.ValidateStruct(Account{Login: "jane_doe", Tier: 1})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [must be alphaNeric characters, dash, and underscore]
+	// [must be alphaNeric characters, dash, and underscore]
+	// []
+	// [{age [must be greater than or equal to 18]}]
+	// []
+}
+
+type Cents struct {
+	N int64
+}
+
+type Order struct {
+	Total Cents `json:"total" v:"gt:0"`
+}
+
+func ExampleValidator_RegisterUnwrapper() {
+	v := validator.New()
+	err := v.RegisterUnwrapper(Cents{}, func(c any) any {
+		return c.(Cents).N
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := v.ValidateStruct(Order{Total: Cents{N: 0}})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+
+	// Unwrappers are registered to a single validator.
+	_, err = validator.New().ValidateStruct(Order{Total: Cents{N: 0}})
+	fmt.Println(err)
+	// Output:
+	// [{total [must be greater than 0]}]
+	// validator: field total: gt: fail to convert {0} to number
+}
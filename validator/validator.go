@@ -2,10 +2,16 @@
 package validator
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
+	"maps"
+	"net/url"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/n4x2/zoo/is"
 	"github.com/n4x2/zoo/regex"
@@ -32,11 +38,18 @@ const (
 var E = map[string]string{
 	"alpha":     "must be alphabetic characters",
 	"alphadash": "must be alphaNeric characters, dash, and underscore",
+	"after":     "must be after %v",
 	"alphanum":  "must be alphanumeric characters",
 	"ascii":     "must be ASCII characters",
+	"before":    "must be before %v",
+	"datetime":  "must be a date-time in %v layout",
+	"decimal":   "must be a decimal with at most %v digits and %v decimal places",
+	"duration":  "invalid duration",
+	"e164":      "invalid E.164 phone number",
 	"enum":      "%v not allowed for this field",
 	"email":     "invalid email address",
 	"equal":     "must be the same as %v",
+	"expr":      "must satisfy %v",
 	"gt":        "must be greater than %v",
 	"gte":       "must be greater than or equal to %v",
 	"lat":       "invalid latitude: %v",
@@ -44,37 +57,99 @@ var E = map[string]string{
 	"lt":        "must be less than %v",
 	"lte":       "must be less than or equal to %v",
 	"lowercase": "must be lowercase characters",
+	"not":       "must not match %v",
+	"or":        "none of the alternatives matched: %v",
+	"password":  "must have %v",
+	"phone":     "invalid phone number of %v",
 	"range":     "value must be in range %v-%v",
+	"required":  "is required",
+	"rfc3339":   "invalid RFC 3339 date-time",
+	"timezone":  "invalid timezone",
 	"ulid":      "invalid ULID",
 	"uuid":      "invalid UUID",
 	"uppercase": "must be uppercase characters",
+	"within":    "must be within %v from now",
 }
 
 // R stores default validation tags, it wraps functions from the [is]
-// package to perform validation.
+// package to perform validation. Functions of rules comparing numbers are
+// instantiated with int: the value is passed as 0 and each parameter as
+// the sign of its difference from the value as returned by [Compare], so
+// numbers are compared exactly in kind of the value.
 //
 // [is]: https://pkg.go.dev/github.com/n4x2/zoo/is
 var R = map[string]Detail{
-	"alpha":     {Fn: is.Alpha, Maxp: 0, N: false},
-	"alphadash": {Fn: is.AlphaDash, Maxp: 0, N: false},
-	"alphanum":  {Fn: is.AlphaNumeric, Maxp: 1, N: false},
-	"ascii":     {Fn: is.ASCII, Maxp: 0, N: false},
-	"enum":      {Fn: is.Contain[[]string, string], Maxp: -1, N: false},
-	"email":     {Fn: is.Email, Maxp: 0, N: false},
-	"equal":     {Fn: is.Equal[float64], Maxp: 1, N: true},
-	"gt":        {Fn: is.GreaterThan[float64], Maxp: 1, N: true},
-	"gte":       {Fn: is.GreaterThanEqual[float64], Maxp: 1, N: true},
-	"lat":       {Fn: is.Latitude, Maxp: 0, N: false},
-	"lon":       {Fn: is.Longitude, Maxp: 0, N: false},
-	"lt":        {Fn: is.LessThan[float64], Maxp: 1, N: true},
-	"lte":       {Fn: is.LessThanEqual[float64], Maxp: 1, N: true},
-	"lowercase": {Fn: is.Lowercase, Maxp: 0, N: false},
-	"range":     {Fn: is.Range[float64], Maxp: 2, N: true},
-	"ulid":      {Fn: is.ULID, Maxp: 0, N: false},
-	"uuid":      {Fn: is.UUID, Maxp: 0, N: false},
-	"uppercase": {Fn: is.Uppercase, Maxp: 0, N: false},
+	"after":     {Fn: is.After, Maxp: 1, N: false, Kinds: TimeKinds},
+	"alpha":     {Fn: is.Alpha, Maxp: 0, N: false, Kinds: StringKinds},
+	"alphadash": {Fn: is.AlphaDash, Maxp: 0, N: false, Kinds: StringKinds},
+	"alphanum":  {Fn: is.AlphaNumeric, Maxp: 1, N: false, Kinds: StringKinds},
+	"ascii":     {Fn: is.ASCII, Maxp: 0, N: false, Kinds: StringKinds},
+	"before":    {Fn: is.Before, Maxp: 1, N: false, Kinds: TimeKinds},
+	"datetime":  {Fn: is.Date, Maxp: 1, N: false, Kinds: DateKinds},
+	"decimal":   {Fn: is.Decimal, Maxp: 2, N: true, Kinds: StringKinds},
+	"duration":  {Fn: is.Duration, Maxp: 0, N: false, Kinds: StringKinds},
+	"e164":      {Fn: is.E164, Maxp: 0, N: false, Kinds: StringKinds},
+	"enum":      {Fn: is.Contain[[]string, string], Maxp: -1, N: false, Kinds: StringKinds},
+	"email":     {Fn: is.EmailAddress, Maxp: -1, N: false, Kinds: StringKinds},
+	"equal":     {Fn: is.Equal[int], Maxp: 1, N: true, Kinds: OrderedKinds},
+	"expr":      {Fn: (*Program).Eval, Maxp: 1, N: false, Raw: true, Absent: true},
+	"gt":        {Fn: is.GreaterThan[int], Maxp: 1, N: true, Kinds: OrderedKinds},
+	"gte":       {Fn: is.GreaterThanEqual[int], Maxp: 1, N: true, Kinds: OrderedKinds},
+	"lat":       {Fn: is.Latitude, Maxp: 0, N: false, Kinds: StringKinds},
+	"lon":       {Fn: is.Longitude, Maxp: 0, N: false, Kinds: StringKinds},
+	"lt":        {Fn: is.LessThan[int], Maxp: 1, N: true, Kinds: OrderedKinds},
+	"lte":       {Fn: is.LessThanEqual[int], Maxp: 1, N: true, Kinds: OrderedKinds},
+	"lowercase": {Fn: is.Lowercase, Maxp: 0, N: false, Kinds: StringKinds},
+	"password":  {Fn: is.Password, Maxp: -1, N: false, Kinds: StringKinds},
+	"phone":     {Fn: is.Phone, Maxp: 1, N: false, Kinds: StringKinds},
+	"range":     {Fn: is.Range[int], Maxp: 2, N: true, Kinds: OrderedKinds},
+	"required":  {Fn: is.Present, Maxp: 0, N: false, Absent: true},
+	"rfc3339":   {Fn: is.RFC3339, Maxp: 0, N: false, Kinds: StringKinds},
+	"timezone":  {Fn: is.Timezone, Maxp: 0, N: false, Kinds: StringKinds},
+	"ulid":      {Fn: is.ULID, Maxp: 0, N: false, Kinds: StringKinds},
+	"uuid":      {Fn: is.UUID, Maxp: 0, N: false, Kinds: StringKinds},
+	"uppercase": {Fn: is.Uppercase, Maxp: 0, N: false, Kinds: StringKinds},
+	"within":    {Fn: is.Within, Maxp: 1, N: false, Kinds: TimeKinds},
 }
 
+// Kinds of values supported by default rules.
+var (
+	// StringKinds supported by rules validating strings.
+	StringKinds = []reflect.Kind{reflect.String}
+	// NumericKinds supported by rules validating numbers.
+	NumericKinds = []reflect.Kind{
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+	}
+	// DateKinds supported by rules validating date-time strings.
+	DateKinds = []reflect.Kind{reflect.String}
+	// TimeKinds supported by rules validating time.Time and date-time
+	// strings.
+	TimeKinds = []reflect.Kind{reflect.Struct, reflect.String}
+)
+
+// TimeLayouts is list of layouts used to parse string values and
+// parameters of date-time rules such as "before" and "after". Layouts
+// are tried in order until one of them succeeds.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	time.DateTime,
+	time.DateOnly,
+}
+
+// NowParam is parameter value of date-time rules that refers to the
+// current time, e.g. "before:now".
+const NowParam = "now"
+
+// RequiredRule is name of rule failing absent and empty values, see
+// [is.Present]. Values are absent if they are nil or nil pointers, such as
+// missing keys of [Validator.ValidateMap] or JSON null, rules skip absent
+// values unless their [Detail] has Absent set like this rule and
+// [ExprRule].
+const RequiredRule = "required"
+
 // Error variables for common error conditions that may be
 // encountered during validation.
 var (
@@ -95,9 +170,12 @@ var (
 type (
 	// Detail holds the tag validation details.
 	Detail struct {
-		Fn   any  // Function for validation.
-		Maxp int  // Maximum allowed parameter.
-		N    bool // Set 'true' if tag processing numerical value.
+		Fn     any            // Function for validation.
+		Maxp   int            // Maximum allowed parameter.
+		N      bool           // Set 'true' if tag processing numerical value.
+		Kinds  []reflect.Kind // Supported kinds of values, nil supports any kind.
+		Raw    bool           // Set 'true' if parameter is kept whole, see [ExprRule].
+		Absent bool           // Set 'true' if rule also validates absent values, see [RequiredRule].
 	}
 
 	// Field represents fields data containing name, value, and
@@ -120,15 +198,22 @@ type (
 	// Result represents a validation error, including the field
 	// name and error messages.
 	Result struct {
-		F string   // The field name.
-		E []string // Error messages.
+		F string   `json:"field"`    // The field name.
+		E []string `json:"messages"` // Error messages.
 	}
 
 	// Validator contains default error messages and validation
 	// rules.
 	Validator struct {
-		msg   map[string]string
-		rules map[string]Detail
+		msg        map[string]string
+		rules      map[string]Detail
+		funcs      map[string]any             // Functions callable from expressions.
+		transforms map[string]Transform       // Modifiers of [ModTag].
+		unwrappers map[reflect.Type]Unwrapper // Unwrappers of values by type.
+		aliases    map[string]alias           // Registered aliases.
+		structs    sync.Map                   // Registered rules of structs keyed by type.
+		plans      sync.Map                   // Compiled rule sets keyed by struct type.
+		mu         sync.RWMutex               // Guards compiling rule sets while struct rules are registered.
 	}
 )
 
@@ -147,6 +232,13 @@ type (
 		t  string // Target type e.g "string".
 		v  any    // The value.
 	}
+
+	// errRulePanic an error type for cases where a rule function
+	// panics.
+	errRulePanic struct {
+		tn string // The tag name.
+		v  any    // The recovered value.
+	}
 )
 
 // Error an error for the errInvalidParam type.
@@ -159,98 +251,161 @@ func (e *errTypeConversion) Error() string {
 	return fmt.Sprintf("%s: fail to convert %v to %s", e.tn, e.v, e.t)
 }
 
-// parseParam parse tag parameter value and convert it into one of this
-// possible types: float64 and string, based on the value format. It
-// returns an error if parsing fails.
-func parseParam(v string) (any, error) {
-	switch {
-	case regex.Numeric.MatchString(v):
-		return to.Float64(v)
-	default:
-		return v, nil
+// Error an error for the errRulePanic type.
+func (e *errRulePanic) Error() string {
+	return fmt.Sprintf("%s: rule panicked: %v", e.tn, e.v)
+}
+
+// Unwrap returns the recovered value if it is an error.
+func (e *errRulePanic) Unwrap() error {
+	err, _ := e.v.(error)
+	return err
+}
+
+// parseTime parse 'v' into time.Time. It accepts time.Time, pointer to
+// time.Time and string formatted in one of [TimeLayouts]. It returns false
+// if the value cannot be parsed.
+func parseTime(v any) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		return *v, true
+	case string:
+		return ParseTime(v)
+	}
+	return time.Time{}, false
+}
+
+// ParseTime parses date-time string 'v' in one of [TimeLayouts], like
+// values of date-time rules are parsed. It returns false if no layout
+// matches.
+func ParseTime(v string) (time.Time, bool) {
+	for _, l := range TimeLayouts {
+		t, err := time.Parse(l, v)
+		if err == nil {
+			return t, true
+		}
 	}
+	return time.Time{}, false
 }
 
-// parseTag parse tag name and parameters. It returns an error if parsing
-// parameter value fails
-func parseTag(v string) ([]Tag, error) {
+// ParseTag parse validator tag 'v' into tag names and parameters, e.g.
+// "gte:18|lt:65". Parameters are kept as strings, so numeric parameters
+// are parsed in kind of the validated value without losing precision. It
+// returns an error if a tag is not supported or parameters are invalid.
+// Tags using [OrKeyword], [NotKeyword] or parentheses are parsed by
+// [ParseExpr].
+func ParseTag(v string) ([]Tag, error) {
 	var s = strings.Split(v, TagSep)
+	for i := range s {
+		// Parameter of raw rule extends to the end of the tag.
+		if n, _, _ := strings.Cut(s[i], PairSep); R[n].Raw {
+			s = append(s[:i], strings.Join(s[i:], TagSep))
+			break
+		}
+	}
 
 	var t = make([]Tag, len(s))
 	for i, tval := range s {
-		var tp = strings.Split(tval, PairSep)
+		pt, err := parseRule(tval)
+		if err != nil {
+			return nil, err
+		}
+		t[i] = pt
+	}
+	return t, nil
+}
 
-		t[i].N = tp[NameIndex]
+// parseRule parse single rule 'v' into tag name and parameters, e.g.
+// "gte:18". It returns an error if the tag is not supported or parameters
+// are invalid.
+func parseRule(v string) (Tag, error) {
+	var tp = strings.SplitN(v, PairSep, 2)
+	var t = Tag{N: tp[NameIndex]}
 
-		m, ok := R[t[i].N]
-		if !ok && t[i].N != SkipTag {
-			return nil, fmt.Errorf("%w: %s", errTagUnsupported, t[i].N)
-		}
+	m, ok := R[t.N]
+	if !ok && t.N != SkipTag {
+		return Tag{}, fmt.Errorf("%w: %s", errTagUnsupported, t.N)
+	}
 
-		if m.Maxp == 0 && len(tp) > 1 {
-			return nil, fmt.Errorf("%w: %s", errParamNotAllowed, t[i].N)
+	if m.Maxp == 0 && len(tp) > 1 {
+		return Tag{}, fmt.Errorf("%w: %s", errParamNotAllowed, t.N)
+	}
+
+	if len(tp) > 1 && m.Raw {
+		t.P = []any{tp[ParamIndex]}
+	} else if len(tp) > 1 {
+		var pv = strings.Split(tp[ParamIndex], ParamSep)
+		if len(pv) != m.Maxp && m.Maxp != -1 {
+			return Tag{}, &errInvalidParam{tn: t.N, v: m.Maxp}
 		}
 
-		if len(tp) > 1 {
-			var pv = strings.Split(tp[ParamIndex], ParamSep)
-			if len(pv) != m.Maxp && m.Maxp != -1 {
-				return nil, &errInvalidParam{tn: t[i].N, v: m.Maxp}
+		for _, pval := range pv {
+			if m.N && !regex.Numeric.MatchString(pval) {
+				return Tag{}, &errInvalidParam{tn: t.N, v: "numeric"}
 			}
 
-			for _, pval := range pv {
-				if m.N && !regex.Numeric.MatchString(pval) {
-					return nil, &errInvalidParam{tn: t[i].N, v: "numeric"}
-				}
-
-				val, err := parseParam(pval)
-				if err != nil {
-					return nil, err
-				}
-				t[i].P = append(t[i].P, val)
-			}
+			t.P = append(t.P, pval)
 		}
 	}
 	return t, nil
 }
 
-// serialize parse value of struct fields. It return an error if input is
-// not a struct, field is unexported, missing validator tag, or fail to
-// parse tag.
-func serialize(v any) ([]Field, error) {
-	if !is.Struct(v) {
-		return nil, errInvalidInput
+// str returns 'v' as string if its kind is string, including named
+// string types, or its text of [encoding.TextMarshaler] or [fmt.Stringer].
+// Other pointers are dereferenced.
+func str(v any) (string, bool) {
+	if s, ok := v.(string); ok {
+		return s, true
 	}
 
-	var s = reflect.ValueOf(v)
-	var t = s.Type()
-	var n = s.NumField()
-
-	var f = make([]Field, n)
-	for i := 0; i < n; i++ {
-		var fi = t.Field(i)
-
-		f[i].N = fi.Name
-		if fi.PkgPath != "" {
-			return nil, fmt.Errorf("field %s : %w", f[i].N, errUnexportedField)
-		}
+	var rv = reflect.ValueOf(v)
+	switch {
+	case rv.Kind() == reflect.String:
+		return rv.String(), true
+	case rv.Kind() == reflect.Pointer && rv.IsNil():
+		return "", false
+	}
 
-		if jn := fi.Tag.Get(JSONTag); jn != "" {
-			f[i].N = jn
-		}
+	switch sv := v.(type) {
+	case encoding.TextMarshaler:
+		b, err := sv.MarshalText()
+		return string(b), err == nil
+	case fmt.Stringer:
+		return sv.String(), true
+	}
 
-		ft, ok := fi.Tag.Lookup(ValidatorTag)
-		if ok && ft == "" {
-			return nil, fmt.Errorf("field %s: %w", f[i].N, errMissingTag)
-		}
+	if rv.Kind() == reflect.Pointer {
+		return str(rv.Elem().Interface())
+	}
+	return "", false
+}
 
-		pt, err := parseTag(ft)
+// strs returns parameters of tag 't' as strings.
+func strs(t Tag) ([]string, error) {
+	var p = make([]string, len(t.P))
+	for i, tp := range t.P {
+		ps, err := to.String(tp)
 		if err != nil {
-			return nil, fmt.Errorf("field %s: %w", f[i].N, err)
+			return nil, &errTypeConversion{tn: t.N, t: "string", v: tp}
 		}
-		f[i].T = pt
-		f[i].V = s.Field(i).Interface()
+		p[i] = ps
 	}
-	return f, nil
+	return p, nil
+}
+
+// fieldName returns name of struct field 'f' from its [JSONTag] without
+// options, or the field name if the tag is missing.
+func fieldName(f reflect.StructField) string {
+	jn, _, _ := strings.Cut(f.Tag.Get(JSONTag), ParamSep)
+	if jn == "" || jn == SkipTag {
+		return f.Name
+	}
+	return jn
 }
 
 // AddRuleNumeric add custom rule that processing numeric values into validator.
@@ -263,9 +418,10 @@ func (r *Validator) AddRuleNumeric(n string, fn func(float64, float64) error, ma
 	}
 
 	r.rules[n] = Detail{
-		Fn:   fn,
-		Maxp: maxp,
-		N:    true,
+		Fn:    fn,
+		Maxp:  maxp,
+		N:     true,
+		Kinds: NumericKinds,
 	}
 	return nil
 }
@@ -279,131 +435,502 @@ func (r *Validator) AddRuleString(n string, fn func(string) error) error {
 	}
 
 	r.rules[n] = Detail{
-		Fn:   fn,
-		Maxp: 0,
-		N:    false,
+		Fn:    fn,
+		Maxp:  0,
+		N:     false,
+		Kinds: StringKinds,
 	}
 	return nil
 }
 
-// ValidateField validate given value based on tags. It returns slices of
+// ValidateField validate given value based on tags. Values are unwrapped
+// before rules are applied: registered [Unwrapper],
+// [database/sql/driver.Valuer] such as sql.NullString, named string, bool
+// and number types, and strings of [encoding.TextMarshaler] or
+// [fmt.Stringer] for rules validating strings. Absent values are only
+// validated by rules such as [RequiredRule]. It returns slices of
 // validation messages if any validation error encountered. It returns an
-// error if rule is not found or type conversion is failed.
+// error if rule is not found, type conversion is failed or a rule function
+// panics. Expressions of [ExprRule] cannot refer to sibling fields.
 func (r *Validator) ValidateField(v any, st []Tag) ([]string, error) {
+	return r.validateField(v, st, nil, false)
+}
+
+// validateField validate 'v' based on tags 'st' like
+// [Validator.ValidateField], resolving sibling fields of expressions by
+// 's'. If 'lax' is true, values that cannot be converted by a rule fail
+// the rule with its message instead of returning an error.
+func (r *Validator) validateField(v any, st []Tag, s Scope, lax bool) (m []string, err error) {
+	var tn string
+	defer func() {
+		if p := recover(); p != nil {
+			m, err = nil, &errRulePanic{tn: tn, v: p}
+		}
+	}()
+
 	var e = make([]string, 0)
 
+	v = r.unwrap(v)
+	var absent = v == nil
 	for _, t := range st {
-		if t.N == SkipTag && v == nil {
+		tn = t.N
+		if t.N == SkipTag {
 			continue
 		}
 
-		m, ok := r.rules[t.N]
-		if !ok && t.N != SkipTag {
+		d, ok := r.rules[t.N]
+		if !ok {
 			return nil, fmt.Errorf("%s: %w", t.N, errTagUnsupported)
 		}
 
-		switch fn := m.Fn.(type) {
-		case func(string) bool:
-			val, ok := v.(string)
-			if !ok {
-				return nil, &errTypeConversion{tn: t.N, t: "string", v: v}
+		if absent && !d.Absent {
+			continue
+		}
+
+		m, err := r.rule(d, v, t, s)
+		if err != nil && !(lax && m != nil) {
+			return nil, err
+		}
+		e = append(e, m...)
+	}
+	return e, nil
+}
+
+// rule validate 'v' against rule 't' having detail 'd', resolving sibling
+// fields of expressions by 's'. It returns messages if the rule fails. If
+// 'v' cannot be converted, it returns messages of the failed rule along
+// with the error, see [Validator.invalid].
+func (r *Validator) rule(d Detail, v any, t Tag, s Scope) ([]string, error) {
+	switch fn := d.Fn.(type) {
+	case func(*Program, any, Scope) (bool, error):
+		if len(t.P) != 1 {
+			return nil, &errInvalidParam{tn: t.N, v: 1}
+		}
+
+		p, ok := t.P[0].(*Program)
+		if !ok {
+			ps, _ := to.String(t.P[0])
+			var err error
+			if p, err = r.ParseProgram(ps); err != nil {
+				return nil, fmt.Errorf("%s: %w", t.N, err)
+			}
+		}
+
+		pass, err := fn(p, v, s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.N, err)
+		}
+
+		if !pass {
+			return []string{fmt.Sprintf(r.msg[t.N], p)}, nil
+		}
+	case func(any) bool:
+		if !fn(v) {
+			return []string{r.msg[t.N]}, nil
+		}
+	case func(string) bool:
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string")
+		}
+
+		if !fn(val) {
+			return []string{r.msg[t.N]}, nil
+		}
+	case func(float64, float64) bool:
+		val, err := to.Float64(v)
+		if err != nil {
+			return r.invalid(t, v, "float64", t.P...)
+		}
+
+		for _, tp := range t.P {
+			p, err := to.Float64(tp)
+			if err != nil {
+				return nil, &errTypeConversion{tn: t.N, t: "float64", v: tp}
+			}
+
+			if !fn(val, p) {
+				return []string{fmt.Sprintf(r.msg[t.N], p)}, nil
 			}
+		}
+	case func(int, int) bool:
+		if _, ok := rat(v); !ok {
+			return r.invalid(t, v, "number", t.P...)
+		}
 
-			if !fn(val) {
-				e = append(e, r.msg[t.N])
+		c, err := compare(v, t)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, tp := range t.P {
+			if !fn(0, c[i]) {
+				return []string{fmt.Sprintf(r.msg[t.N], tp)}, nil
 			}
-		case func(float64, float64) bool:
-			val, err := to.Float64(v)
+		}
+	case func(int, int, int) bool:
+		if len(t.P) != 2 {
+			return nil, &errInvalidParam{tn: t.N, v: 2}
+		}
+
+		if _, ok := rat(v); !ok {
+			return r.invalid(t, v, "number", t.P...)
+		}
+
+		c, err := compare(v, t)
+		if err != nil {
+			return nil, err
+		}
+
+		if !fn(c[0], c[1], 0) {
+			return []string{fmt.Sprintf(r.msg[t.N], t.P[0], t.P[1])}, nil
+		}
+	case func(string, int, int) bool:
+		if len(t.P) != 2 {
+			return nil, &errInvalidParam{tn: t.N, v: 2}
+		}
+
+		var p [2]int
+		for i, tp := range t.P {
+			pi, err := to.Int(tp)
 			if err != nil {
-				return nil, &errTypeConversion{tn: t.N, t: "float64", v: v}
+				return nil, &errTypeConversion{tn: t.N, t: "int", v: tp}
 			}
+			p[i] = pi
+		}
+
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string", p[0], p[1])
+		}
+
+		if !fn(val, p[0], p[1]) {
+			return []string{fmt.Sprintf(r.msg[t.N], p[0], p[1])}, nil
+		}
+	case func(string, is.PasswordPolicy) []string:
+		p, err := strs(t)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := ParsePasswordPolicy(p...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.N, err)
+		}
 
-			for _, tp := range t.P {
-				p, err := to.Float64(tp)
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string", requirements(policy, is.Password("", policy)))
+		}
+
+		if unmet := fn(val, policy); len(unmet) > 0 {
+			return []string{fmt.Sprintf(r.msg[t.N], requirements(policy, unmet))}, nil
+		}
+	case func(string, is.EmailPolicy) bool:
+		p, err := strs(t)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := ParseEmailPolicy(p...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", t.N, err)
+		}
+
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string")
+		}
+
+		if !fn(val, policy) {
+			return []string{r.msg[t.N]}, nil
+		}
+	case func(float64, float64, float64) bool:
+		if len(t.P) != 2 {
+			return nil, &errInvalidParam{tn: t.N, v: 2}
+		}
+
+		var p [2]float64
+		for i, tp := range t.P {
+			var err error
+			p[i], err = to.Float64(tp)
+			if err != nil {
+				return nil, &errTypeConversion{tn: t.N, t: "float64", v: tp}
+			}
+		}
+
+		val, err := to.Float64(v)
+		if err != nil {
+			return r.invalid(t, v, "float64", p[0], p[1])
+		}
+
+		if !fn(p[0], p[1], val) {
+			return []string{fmt.Sprintf(r.msg[t.N], p[0], p[1])}, nil
+		}
+	case func([]string, string) bool:
+		var p = make([]string, len(t.P))
+		for i, tp := range t.P {
+			val, ok := tp.(string)
+			if !ok {
+				return nil, &errTypeConversion{tn: t.N, t: "string", v: tp}
+			}
+			p[i] = val
+		}
+
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string", v)
+		}
+
+		if !fn(p, val) {
+			return []string{fmt.Sprintf(r.msg[t.N], v)}, nil
+		}
+	case func(string, string) bool:
+		val, ok := str(v)
+		switch v.(type) {
+		case time.Time, *time.Time:
+			ok = false
+		}
+
+		if !ok {
+			return r.invalid(t, v, "string", t.P...)
+		}
+
+		for _, tp := range t.P {
+			p, err := to.String(tp)
+			if err != nil {
+				return nil, &errTypeConversion{tn: t.N, t: "string", v: tp}
+			}
+
+			if !fn(val, p) {
+				return []string{fmt.Sprintf(r.msg[t.N], p)}, nil
+			}
+		}
+	case func(time.Time, time.Time) bool:
+		for _, tp := range t.P {
+			var p = time.Now()
+			if tp != NowParam {
+				ps, err := to.String(tp)
 				if err != nil {
-					return nil, &errTypeConversion{tn: t.N, t: "float64", v: v}
+					return nil, &errTypeConversion{tn: t.N, t: "time.Time", v: tp}
 				}
 
-				if !fn(val, p) {
-					e = append(e, fmt.Sprintf(r.msg[t.N], p))
-					break
-				}
-			}
-		case func([]string, string) bool:
-			var p = make([]string, len(t.P))
-			for i, tp := range t.P {
-				val, ok := tp.(string)
+				var ok bool
+				p, ok = parseTime(ps)
 				if !ok {
-					return nil, &errTypeConversion{tn: t.N, t: "string", v: v}
+					return nil, &errTypeConversion{tn: t.N, t: "time.Time", v: tp}
 				}
-				p[i] = val
 			}
 
-			val, ok := v.(string)
-			if !ok {
-				return nil, &errTypeConversion{tn: t.N, t: "string", v: v}
+			val, ok := parseTime(v)
+			if !ok || !fn(val, p) {
+				return []string{fmt.Sprintf(r.msg[t.N], tp)}, nil
 			}
-
-			if !fn(p, val) {
-				e = append(e, fmt.Sprintf(r.msg[t.N], v))
-			}
-		case func(string) error:
-			err := fn(v.(string))
+		}
+	case func(time.Time, time.Duration) bool:
+		for _, tp := range t.P {
+			ps, err := to.String(tp)
 			if err != nil {
-				e = append(e, err.Error())
+				return nil, &errTypeConversion{tn: t.N, t: "time.Duration", v: tp}
 			}
-		case func(float64, float64) error:
-			val, err := to.Float64(v)
+
+			p, err := time.ParseDuration(ps)
 			if err != nil {
-				return nil, &errTypeConversion{tn: t.N, t: "float64", v: v}
+				return nil, &errTypeConversion{tn: t.N, t: "time.Duration", v: tp}
 			}
 
-			for _, tp := range t.P {
-				p, err := to.Float64(tp)
-				if err != nil {
-					return nil, &errTypeConversion{tn: t.N, t: "float64", v: v}
-				}
+			val, ok := parseTime(v)
+			if !ok || !fn(val, p) {
+				return []string{fmt.Sprintf(r.msg[t.N], p)}, nil
+			}
+		}
+	case func(string) error:
+		val, ok := str(v)
+		if !ok {
+			return r.invalid(t, v, "string")
+		}
 
-				err = fn(val, p)
-				if err != nil {
-					e = append(e, err.Error())
-					break
-				}
+		if err := fn(val); err != nil {
+			return []string{err.Error()}, nil
+		}
+	case func(float64, float64) error:
+		val, err := to.Float64(v)
+		if err != nil {
+			return r.invalid(t, v, "float64", t.P...)
+		}
+
+		for _, tp := range t.P {
+			p, err := to.Float64(tp)
+			if err != nil {
+				return nil, &errTypeConversion{tn: t.N, t: "float64", v: tp}
+			}
+
+			if err := fn(val, p); err != nil {
+				return []string{err.Error()}, nil
 			}
 		}
 	}
-	return e, nil
+	return nil, nil
+}
+
+// invalid returns message of rule 't' failed by value 'v' that cannot be
+// converted into 'want' type, formatted with parameters 'p', along with
+// the conversion error. Rules without message in [E] fail with "invalid
+// value".
+func (r *Validator) invalid(t Tag, v any, want string, p ...any) ([]string, error) {
+	var msg, ok = r.msg[t.N]
+	if !ok {
+		msg = "invalid value"
+	}
+
+	if n := strings.Count(msg, "%v"); n < len(p) {
+		p = p[:n]
+	}
+	return []string{fmt.Sprintf(msg, p...)}, &errTypeConversion{tn: t.N, t: want, v: v}
 }
 
 // ValidateStruct validate given struct based on their associated tags.
-// It will returns slices of [Result] containing field name and error
-// messages if any validation error encountered. It returns an error if
-// input is not struct or failed to parse fields or failed to convert
-// values.
+// Tags are compiled once per struct type, see [Validator.Compile]. It
+// will returns slices of [Result] containing field name and error
+// messages if any validation error encountered. If 'v' is a pointer to
+// struct, defaults of [DefaultTag] and modifiers of [ModTag] are applied
+// in place before validation, see [Validator.Modify]. It returns an error
+// if input is not struct, failed to compile rules, failed to convert
+// values or a modifier panics.
 func (r *Validator) ValidateStruct(v any) ([]Result, error) {
-	pf, err := serialize(v)
+	var rv = reflect.ValueOf(v)
+	var ptr = rv.Kind() == reflect.Pointer && !rv.IsNil()
+	if ptr {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validator: %w", errInvalidInput)
+	}
+
+	rs, err := r.plan(rv.Type())
 	if err != nil {
-		return nil, fmt.Errorf("validator: %w", err)
+		return nil, err
 	}
 
+	if ptr {
+		if err := r.modify(rv, rs); err != nil {
+			return nil, fmt.Errorf("validator: %w", err)
+		}
+	}
+
+	var s = structScope(rv)
 	var res = make([]Result, 0)
-	for _, f := range pf {
-		m, err := r.ValidateField(f.V, f.T)
+	for i, f := range rs.fields {
+		if f.expr == nil {
+			continue
+		}
+
+		m, err := r.validateExpr(rv.Field(i).Interface(), f.expr, s, false)
 		if err != nil {
-			return nil, fmt.Errorf("validator: %w", err)
+			return nil, fmt.Errorf("validator: field %s: %w", f.name, err)
 		}
 
 		if len(m) > 0 {
-			res = append(res, Result{F: f.N, E: m})
+			res = append(res, Result{F: f.name, E: m})
 		}
 	}
 	return res, nil
 }
 
+// ValidateMap validate given values based on 'rules' mapping key into
+// validator tag, e.g. {"age": "gte:18"}. Keys missing from 'v' are
+// absent, so only rules such as [RequiredRule] validate them, and values
+// that a rule cannot convert fail the rule, e.g. "abc" of "gte:18". It
+// will returns slices of [Result] sorted by key if any validation error
+// encountered. It returns an error if failed to parse rules or a rule
+// parameter.
+func (r *Validator) ValidateMap(v map[string]any, rules map[string]string) ([]Result, error) {
+	return r.validateRules(rules, func(n string) []any {
+		return []any{v[n]}
+	}, func(n string) (any, bool) {
+		mv, ok := v[n]
+		return mv, ok
+	})
+}
+
+// ValidateValues validate given url.Values such as query parameters or
+// form values based on 'rules', see [Validator.ValidateMap]. Every value
+// of a key is validated, missing keys are absent.
+func (r *Validator) ValidateValues(v url.Values, rules map[string]string) ([]Result, error) {
+	return r.validateRules(rules, func(n string) []any {
+		if len(v[n]) == 0 {
+			return []any{nil}
+		}
+
+		var s = make([]any, len(v[n]))
+		for i, val := range v[n] {
+			s[i] = val
+		}
+		return s
+	}, func(n string) (any, bool) {
+		return v.Get(n), v.Has(n)
+	})
+}
+
+// validateRules validate values returned by 'values' for each key of
+// 'rules' in sorted order, expressions of [ExprRule] resolve other keys by
+// 's'. Messages of a key with multiple values are merged without
+// duplicates.
+func (r *Validator) validateRules(rules map[string]string, values func(string) []any, s Scope) ([]Result, error) {
+	var k = make([]string, 0, len(rules))
+	for n := range rules {
+		k = append(k, n)
+	}
+	sort.Strings(k)
+
+	var res = make([]Result, 0)
+	for _, n := range k {
+		if rules[n] == "" {
+			return nil, fmt.Errorf("validator: field %s: %w", n, errMissingTag)
+		}
+
+		pe, err := r.parseExpr(rules[n])
+		if err == nil {
+			err = r.programs(pe, nil)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("validator: field %s: %w", n, err)
+		}
+
+		var e []string
+		for _, v := range values(n) {
+			m, err := r.validateExpr(v, pe, s, true)
+			if err != nil {
+				return nil, fmt.Errorf("validator: field %s: %w", n, err)
+			}
+
+			for _, msg := range m {
+				if !is.Contain(e, msg) {
+					e = append(e, msg)
+				}
+			}
+		}
+
+		if len(e) > 0 {
+			res = append(res, Result{F: n, E: e})
+		}
+	}
+	return res, nil
+}
+
+// std is default validator used by package level functions.
+var std = New()
+
 // New creates new validator instances.
 func New() *Validator {
 	return &Validator{
-		msg:   E,
-		rules: R,
+		msg:        E,
+		rules:      R,
+		funcs:      maps.Clone(Funcs),
+		transforms: maps.Clone(Transforms),
+		unwrappers: maps.Clone(Unwrappers),
+		aliases:    make(map[string]alias),
 	}
 }
@@ -3,7 +3,12 @@ package validator_test
 import (
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net/url"
 	"regexp"
+	"time"
+	"unicode"
 
 	"github.com/n4x2/zoo/validator"
 )
@@ -103,3 +108,152 @@ func ExampleValidator_AddRuleString() {
 	// Output:
 	// [{book_title [must be start case]}]
 }
+
+type Booking struct {
+	Date     string    `json:"date" v:"datetime:2006-01-02|after:2020-01-01"`
+	CheckIn  time.Time `json:"check_in" v:"before:now"`
+	Reminder time.Time `json:"reminder" v:"within:720h"`
+	Timeout  string    `json:"timeout" v:"duration"`
+	Timezone string    `json:"timezone" v:"timezone"`
+}
+
+func Example_time() {
+	var b = Booking{
+		Date:     "2019-12-31",
+		CheckIn:  time.Date(2021, time.March, 1, 14, 0, 0, 0, time.UTC),
+		Reminder: time.Now().Add(-1000 * time.Hour),
+		Timeout:  "1h30m",
+		Timezone: "Asia/Jakarta",
+	}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(b)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{date [must be after 2020-01-01]} {reminder [must be within 720h0m0s from now]}]
+}
+
+func ExampleValidator_ValidateMap() {
+	// Missing "plan" is absent and "abc" fails the rule comparing numbers.
+	var rules = map[string]string{
+		"username": "alphadash|lowercase",
+		"age":      "gte:18",
+		"plan":     "enum:free,pro",
+	}
+
+	v := validator.New()
+	result, err := v.ValidateMap(map[string]any{
+		"username": "John_Doe",
+		"age":      "abc",
+	}, rules)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{age [must be greater than or equal to 18]} {username [must be lowercase characters]}]
+}
+
+func ExampleValidator_ValidateValues() {
+	query, err := url.ParseQuery("page=0&sort=name&sort=Age&since=2024-13-01")
+	if err != nil {
+		panic(err)
+	}
+
+	v := validator.New()
+	result, err := v.ValidateValues(query, map[string]string{
+		"page":  "gte:1",
+		"sort":  "lowercase",
+		"since": "datetime:2006-01-02",
+		"limit": "gte:1|lte:100",
+		"q":     "required",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{page [must be greater than or equal to 1]} {q [is required]} {since [must be a date-time in 2006-01-02 layout]} {sort [must be lowercase characters]}]
+}
+
+// Initial checks that the value starts with uppercase letter, it panics on
+// empty string.
+func Initial(v string) error {
+	if !unicode.IsUpper(rune(v[0])) {
+		return errors.New("must start with uppercase letter")
+	}
+	return nil
+}
+
+func ExampleValidator_ValidateField_panic() {
+	v := validator.New()
+	if err := v.AddRuleString("initial", Initial); err != nil {
+		panic(err)
+	}
+
+	_, err := v.ValidateMap(map[string]any{"name": ""}, map[string]string{"name": "initial"})
+	fmt.Println(err)
+	// Output:
+	// validator: field name: initial: rule panicked: runtime error: index out of range [0] with length 0
+}
+
+type Invoice struct {
+	ID    int64    `json:"id" v:"gt:9007199254740992"`
+	Rate  float32  `json:"rate" v:"lte:0.1"`
+	Total string   `json:"total" v:"decimal:6,2|gte:0.01"`
+	Limit *big.Int `json:"limit" v:"lt:18446744073709551616"`
+}
+
+func Example_numeric() {
+	var i = Invoice{
+		ID:    9007199254740992,
+		Rate:  0.1,
+		Total: "10.005",
+		Limit: new(big.Int).Lsh(big.NewInt(1), 64),
+	}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(i)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{id [must be greater than 9007199254740992]} {total [must be a decimal with at most 6 digits and 2 decimal places]} {limit [must be less than 18446744073709551616]}]
+}
+
+func ExampleCompare() {
+	fmt.Println(validator.Compare(int64(9007199254740993), "9007199254740992"))
+	fmt.Println(validator.Compare(float32(0.1), "0.1"))
+	fmt.Println(validator.Compare(math.NaN(), 100))
+	// Output:
+	// 1 <nil>
+	// 0 <nil>
+	// 0 not a number
+}
+
+type Contact struct {
+	Mobile string `json:"mobile" v:"phone:ID"`
+	Office string `json:"office" v:"phone:GB or e164"`
+}
+
+func Example_phone() {
+	var c = Contact{Mobile: "+44 20 7946 0958", Office: "020 7946 0958"}
+
+	v := validator.New()
+	result, err := v.ValidateStruct(c)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(result)
+	// Output:
+	// [{mobile [invalid phone number of ID]}]
+}